@@ -0,0 +1,70 @@
+package addressEncoder
+
+import (
+	"encoding/hex"
+	"errors"
+	"strings"
+)
+
+// case folding policies of AddressType.CaseFold
+const (
+	// CaseFoldNone passes the address to the decoder as it is, base58 is case sensitive
+	CaseFoldNone = "none"
+	// CaseFoldLowerOnly only accepts lower case addresses
+	CaseFoldLowerOnly = "lowerOnly"
+	// CaseFoldInsensitive accepts an address in either case but not mixed, as bech32 and cashaddr do
+	CaseFoldInsensitive = "insensitive"
+	// CaseFoldEIP55 accepts single case hex, mixed case hex must carry a valid eip55 checksum
+	CaseFoldEIP55 = "eip55"
+)
+
+var (
+	ErrorUnknownCaseFold = errors.New("Unknown case fold policy!")
+)
+
+// caseFoldOf returns the case folding policy of addresstype. Unless CaseFold is set, it is derived from
+// the encode type: insensitive for bech32, iota, cashaddr and kaspa and none for the rest. Eip55 addresses are
+// not checked against their checksum unless CaseFold asks for it, AddressDecode has always taken any casing.
+func caseFoldOf(addresstype AddressType) string {
+	if addresstype.CaseFold != "" {
+		return addresstype.CaseFold
+	}
+	switch addresstype.EncodeType {
+	case "bech32", "iota", "base32PolyMod", "kaspa":
+		return CaseFoldInsensitive
+	}
+	return CaseFoldNone
+}
+
+// foldCase checks address against policy and returns the form to decode
+func foldCase(address, policy string) (string, error) {
+	lower := strings.ToLower(address)
+	switch policy {
+	case CaseFoldNone:
+		return address, nil
+	case CaseFoldLowerOnly:
+		if lower != address {
+			return "", ErrorInvalidAddress
+		}
+		return address, nil
+	case CaseFoldInsensitive:
+		if lower != address && strings.ToUpper(address) != address {
+			return "", ErrorInvalidAddress
+		}
+		return lower, nil
+	case CaseFoldEIP55:
+		digits := address
+		if strings.HasPrefix(digits, "0x") {
+			digits = digits[2:]
+		}
+		if strings.ToLower(digits) == digits || strings.ToUpper(digits) == digits {
+			return address, nil
+		}
+		addr, err := hex.DecodeString(digits)
+		if err != nil || eip55Checksum(addr) != "0x"+digits {
+			return "", ErrorInvalidAddress
+		}
+		return address, nil
+	}
+	return "", ErrorUnknownCaseFold
+}
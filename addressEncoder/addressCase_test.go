@@ -0,0 +1,59 @@
+package addressEncoder
+
+import (
+	"testing"
+)
+
+func Test_CaseFold(t *testing.T) {
+	eth := ETH_mainnetPublicAddress
+	eth.CaseFold = CaseFoldEIP55
+	lowerOnly := BTC_mainnetAddressBech32V0
+	lowerOnly.CaseFold = CaseFoldLowerOnly
+	insensitiveBase58 := BTC_mainnetAddressP2PKH
+	insensitiveBase58.CaseFold = CaseFoldInsensitive
+
+	tests := []struct {
+		address     string
+		addresstype AddressType
+		ok          bool
+	}{
+		// none, the default of base58
+		{"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", BTC_mainnetAddressP2PKH, true},
+		{"1bvbmseystwetqtfn5au4m4gfg7xjannvn2", BTC_mainnetAddressP2PKH, false},
+		// insensitive, the default of bech32 and cashaddr
+		{"bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4", BTC_mainnetAddressBech32V0, true},
+		{"BC1QW508D6QEJXTDG4Y5R3ZARVARY0C5XW7KV8F3T4", BTC_mainnetAddressBech32V0, true},
+		{"bc1qW508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4", BTC_mainnetAddressBech32V0, false},
+		{"BC1QW508D6QEJXTDG4Y5R3ZARVARY0C5XW7\u212aV8F3T4", BTC_mainnetAddressBech32V0, false},
+		{"BITCOINCASH:QPM2QSZNHKS23Z7629MMS6S4CWEF74VCWVY22GDX6A", BCH_mainnetAddressCash, true},
+		{"bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdX6a", BCH_mainnetAddressCash, false},
+		// a case sensitive encoding folded to lower case no longer decodes
+		{"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", insensitiveBase58, false},
+		// lowerOnly
+		{"bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4", lowerOnly, true},
+		{"BC1QW508D6QEJXTDG4Y5R3ZARVARY0C5XW7KV8F3T4", lowerOnly, false},
+		// eip55
+		{"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", eth, true},
+		{"0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", eth, true},
+		{"0x5AAEB6053F3E94C9B9A09F33669435E7EF1BEAED", eth, true},
+		{"5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", eth, true},
+		{"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD", eth, false},
+		// the eip55 preset itself takes any casing
+		{"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD", ETH_mainnetPublicAddress, true},
+	}
+	for _, test := range tests {
+		_, err := AddressDecode(test.address, test.addresstype)
+		if test.ok && err != nil {
+			t.Errorf("%s (%s): %v", test.address, caseFoldOf(test.addresstype), err)
+		}
+		if !test.ok && err == nil {
+			t.Errorf("%s (%s) accepted", test.address, caseFoldOf(test.addresstype))
+		}
+	}
+
+	unknown := BTC_mainnetAddressP2PKH
+	unknown.CaseFold = "upperOnly"
+	if _, err := AddressDecode("1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", unknown); err != ErrorUnknownCaseFold {
+		t.Error("unknown policy:", err)
+	}
+}
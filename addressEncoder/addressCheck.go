@@ -210,6 +210,14 @@ func AddressCheck(addr string, symbol string) (bool, error) {
 				return false, err
 			}
 		}
+		if addr[0] == 't' && addr[1] == 'z' && addr[2] == '4' {
+			_, err = AddressDecode(addr, XTZ_mainnetAddress_tz4)
+			if err == nil {
+				return true, err
+			} else {
+				return false, err
+			}
+		}
 		//other type(TODO)
 	case "HC":
 		if addr[0] == 'H' && addr[1] == 's' {
@@ -0,0 +1,36 @@
+package addressEncoder
+
+import (
+	"errors"
+)
+
+// checksum scopes of AddressType.ChecksumScope, the bytes of a base58 address its checksum is taken over
+const (
+	// ChecksumScopeAll takes the checksum over Prefix||hash||Suffix, the base58check default
+	ChecksumScopeAll = "all"
+	// ChecksumScopeNoPrefix leaves out the Prefix, for a display tag that is not part of the checksum input
+	ChecksumScopeNoPrefix = "noPrefix"
+	// ChecksumScopeNoSuffix leaves out the Suffix
+	ChecksumScopeNoSuffix = "noSuffix"
+	// ChecksumScopeHash takes the checksum over the hash alone
+	ChecksumScopeHash = "hash"
+)
+
+var (
+	ErrorUnknownChecksumScope = errors.New("Unknown checksum scope!")
+)
+
+// checksumInput returns the bytes the checksum is calculated from under scope, an empty scope is ChecksumScopeAll
+func checksumInput(prefix, hash, suffix []byte, scope string) ([]byte, error) {
+	switch scope {
+	case "", ChecksumScopeAll:
+		return catData(catData(prefix, hash), suffix), nil
+	case ChecksumScopeNoPrefix:
+		return catData(hash, suffix), nil
+	case ChecksumScopeNoSuffix:
+		return catData(prefix, hash), nil
+	case ChecksumScopeHash:
+		return catData(hash, nil), nil
+	}
+	return nil, ErrorUnknownChecksumScope
+}
@@ -0,0 +1,70 @@
+package addressEncoder
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+func Test_ChecksumScope(t *testing.T) {
+	hash, _ := hex.DecodeString("751e76e8199196d454941c45d1b3a323f1433bd6")
+	// a two byte display tag left out of the checksum
+	tagged := BTC_mainnetAddressP2PKH
+	tagged.Prefix = []byte{0x1c, 0xb8}
+	tagged.ChecksumScope = ChecksumScopeNoPrefix
+
+	address := AddressEncode(hash, tagged)
+	decoded, err := Base58Decode(address, NewBase58Alphabet(tagged.Alphabet))
+	if err != nil {
+		t.Fatalf("base58 decode %s failed: %v", address, err)
+	}
+	if !bytes.Equal(decoded[:2], tagged.Prefix) || !bytes.Equal(decoded[2:22], hash) {
+		t.Errorf("encoded data: got %x", decoded)
+	}
+	if chk := calcChecksum(hash, tagged.ChecksumType); !bytes.Equal(decoded[22:], chk) {
+		t.Errorf("checksum: got %x, want %x over the hash only", decoded[22:], chk)
+	}
+	data, err := AddressDecode(address, tagged)
+	if err != nil || !bytes.Equal(data, hash) {
+		t.Errorf("decode %s failed: %x, %v", address, data, err)
+	}
+
+	// the same bytes do not check under the default scope, nor a default address under the tagged one
+	whole := tagged
+	whole.ChecksumScope = ""
+	if _, err := AddressDecode(address, whole); err != ErrorInvalidAddress {
+		t.Errorf("decode with the whole scope: got %v", err)
+	}
+	if _, err := AddressDecode(AddressEncode(hash, whole), tagged); err != ErrorInvalidAddress {
+		t.Errorf("decode a whole scope address: got %v", err)
+	}
+	whole.ChecksumScope = ChecksumScopeAll
+	if AddressEncode(hash, whole) != AddressEncode(hash, BTC_mainnetAddressP2PKH.WithPrefix(tagged.Prefix)) {
+		t.Errorf("the all scope differs from the default")
+	}
+
+	// the suffix is covered unless the scope leaves it out
+	suffixed := BTC_mainnetAddressP2PKH
+	suffixed.Suffix = []byte{0x01}
+	for _, scope := range []string{ChecksumScopeNoSuffix, ChecksumScopeHash} {
+		suffixed.ChecksumScope = scope
+		address := AddressEncode(hash, suffixed)
+		decoded, _ := Base58Decode(address, NewBase58Alphabet(suffixed.Alphabet))
+		input, _ := checksumInput(suffixed.Prefix, hash, suffixed.Suffix, scope)
+		if !bytes.Equal(decoded[len(decoded)-4:], calcChecksum(input, suffixed.ChecksumType)) {
+			t.Errorf("scope %s: wrong checksum in %x", scope, decoded)
+		}
+		if data, err := AddressDecode(address, suffixed); err != nil || !bytes.Equal(data, hash) {
+			t.Errorf("scope %s: decode %s failed: %x, %v", scope, address, data, err)
+		}
+	}
+
+	unknown := BTC_mainnetAddressP2PKH
+	unknown.ChecksumScope = "prefixOnly"
+	if address := AddressEncode(hash, unknown); address != "" {
+		t.Errorf("unknown scope encoded to %s", address)
+	}
+	if _, err := AddressDecode("1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH", unknown); err != ErrorUnknownChecksumScope {
+		t.Errorf("unknown scope decode: got %v", err)
+	}
+}
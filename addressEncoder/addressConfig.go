@@ -0,0 +1,110 @@
+package addressEncoder
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+)
+
+// addressTypeConfig is the definition of an address type in a config file, the prefix and suffix are hex
+type addressTypeConfig struct {
+	EncodeType         string `json:"encodeType"`
+	Alphabet           string `json:"alphabet"`
+	ChecksumType       string `json:"checksumType"`
+	HashType           string `json:"hashType"`
+	HashLen            int    `json:"hashLen"`
+	HashLens           []int  `json:"hashLens"`
+	ScriptHash         bool   `json:"scriptHash"`
+	Prefix             string `json:"prefix"`
+	Suffix             string `json:"suffix"`
+	Bech32Variant      string `json:"bech32Variant"`
+	HashTruncateOffset int    `json:"hashTruncateOffset"`
+	HashTruncateLength int    `json:"hashTruncateLength"`
+	CaseFold           string `json:"caseFold"`
+	ChecksumScope      string `json:"checksumScope"`
+}
+
+// defaultAlphabets is the alphabet of each encode type when a config gives none
+var defaultAlphabets = map[string]string{
+	"base58":        BTCAlphabet,
+	"eos":           BTCAlphabet,
+	"aeternity":     BTCAlphabet,
+	"XMR":           XMRAlphabet,
+	"bech32":        BTCBech32Alphabet,
+	"iota":          BTCBech32Alphabet,
+	"base32PolyMod": BCHCashAlphabet,
+	"kaspa":         KASAlphabet,
+	"filecoin":      FILAlphabet,
+}
+
+func (c addressTypeConfig) addressType() (AddressType, error) {
+	prefix, err := hex.DecodeString(c.Prefix)
+	if err != nil {
+		return AddressType{}, fmt.Errorf("prefix: %w", err)
+	}
+	suffix, err := hex.DecodeString(c.Suffix)
+	if err != nil {
+		return AddressType{}, fmt.Errorf("suffix: %w", err)
+	}
+	at := AddressType{
+		EncodeType:         c.EncodeType,
+		Alphabet:           c.Alphabet,
+		ChecksumType:       c.ChecksumType,
+		HashType:           c.HashType,
+		HashLen:            c.HashLen,
+		HashLens:           c.HashLens,
+		ScriptHash:         c.ScriptHash,
+		Prefix:             prefix,
+		Suffix:             suffix,
+		Bech32Variant:      c.Bech32Variant,
+		HashTruncateOffset: c.HashTruncateOffset,
+		HashTruncateLength: c.HashTruncateLength,
+		CaseFold:           c.CaseFold,
+		ChecksumScope:      c.ChecksumScope,
+	}
+	if at.Alphabet == "" {
+		at.Alphabet = defaultAlphabets[at.EncodeType]
+	}
+	// the presets leave an unused prefix or suffix nil
+	if len(at.Prefix) == 0 {
+		at.Prefix = nil
+	}
+	if len(at.Suffix) == 0 {
+		at.Suffix = nil
+	}
+	return at, nil
+}
+
+// LoadAddressTypes reads the address types of a JSON file mapping each coin name to its definition, such as
+//
+//	{"RVN": {"encodeType": "base58", "checksumType": "doubleSHA256", "hashType": "h160", "hashLen": 20, "prefix": "3c"}}
+//
+// The keys are the AddressType fields in lower camel case, with the prefix and suffix in hex, and the alphabet
+// defaults to the usual one of the encode type. Every type is checked with SelfTest, so a misconfigured coin
+// fails to load with an error naming it.
+func LoadAddressTypes(path string) (map[string]AddressType, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var configs map[string]addressTypeConfig
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&configs); err != nil {
+		return nil, err
+	}
+	ret := make(map[string]AddressType, len(configs))
+	for coin, config := range configs {
+		at, err := config.addressType()
+		if err == nil {
+			err = at.SelfTest()
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", coin, err)
+		}
+		ret[coin] = at
+	}
+	return ret, nil
+}
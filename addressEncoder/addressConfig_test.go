@@ -0,0 +1,72 @@
+package addressEncoder
+
+import (
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func writeConfig(t *testing.T, content string) string {
+	f, err := ioutil.TempFile("", "addressTypes*.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if _, err := f.WriteString(content); err != nil {
+		t.Fatal(err)
+	}
+	return f.Name()
+}
+
+func Test_LoadAddressTypes(t *testing.T) {
+	path := writeConfig(t, `{
+	"RVN": {"encodeType": "base58", "checksumType": "doubleSHA256", "hashType": "h160", "hashLen": 20, "prefix": "3c"},
+	"SEGWIT": {"encodeType": "bech32", "checksumType": "bc", "hashType": "h160", "hashLen": 20, "prefix": "00"}
+}`)
+	defer os.Remove(path)
+
+	types, err := LoadAddressTypes(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(types) != 2 {
+		t.Fatalf("loaded %d types, want 2", len(types))
+	}
+	vectors := []struct {
+		coin    string
+		hash    string
+		address string
+	}{
+		{"RVN", "6231f1005e86c03d5fbd41776985d094ccb682d3", "RJEQ8JgCqYhgYqzLC2R3La4H1DoBfvPwrp"},
+		{"SEGWIT", "751e76e8199196d454941c45d1b3a323f1433bd6", "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4"},
+	}
+	for _, v := range vectors {
+		hash, _ := hex.DecodeString(v.hash)
+		if address := AddressEncode(hash, types[v.coin]); address != v.address {
+			t.Errorf("%s: encode gave %s, want %s", v.coin, address, v.address)
+		}
+	}
+	if types["RVN"].Alphabet != BTCAlphabet || types["SEGWIT"].Alphabet != BTCBech32Alphabet {
+		t.Error("default alphabet not set")
+	}
+}
+
+func Test_LoadAddressTypes_invalid(t *testing.T) {
+	configs := map[string]string{
+		"bad json":      `{"RVN": `,
+		"unknown field": `{"RVN": {"encodeType": "base58", "hashLen": 20, "prefixes": "3c"}}`,
+		"bad prefix":    `{"RVN": {"encodeType": "base58", "checksumType": "doubleSHA256", "hashLen": 20, "prefix": "3"}}`,
+		"no hash len":   `{"RVN": {"encodeType": "base58", "checksumType": "doubleSHA256", "prefix": "3c"}}`,
+	}
+	for name, content := range configs {
+		path := writeConfig(t, content)
+		if _, err := LoadAddressTypes(path); err == nil {
+			t.Errorf("%s: loaded", name)
+		}
+		os.Remove(path)
+	}
+	if _, err := LoadAddressTypes("/nonexistent/addressTypes.json"); err == nil {
+		t.Error("missing file loaded")
+	}
+}
@@ -0,0 +1,69 @@
+package addressEncoder
+
+import "errors"
+
+var (
+	ErrorAmbiguousAddress = errors.New("Address matches more than one address type!")
+)
+
+// DetectAddressType returns the first of candidates, in slice order, that address decodes with.
+// Since coins such as BTC and BSV share version bytes, an address may match several candidates;
+// the order of the slice decides which one is returned, the same one on every call.
+// ErrorInvalidAddress is returned if none matches.
+func DetectAddressType(address string, candidates []AddressType) (AddressType, error) {
+	for _, addresstype := range candidates {
+		if _, err := AddressDecode(address, addresstype); err == nil {
+			return addresstype, nil
+		}
+	}
+	return AddressType{}, ErrorInvalidAddress
+}
+
+// DetectAddressTypeStrict works like DetectAddressType but returns ErrorAmbiguousAddress if address
+// matches more than one of candidates, instead of taking the first.
+func DetectAddressTypeStrict(address string, candidates []AddressType) (AddressType, error) {
+	var found []AddressType
+	for _, addresstype := range candidates {
+		if _, err := AddressDecode(address, addresstype); err == nil {
+			found = append(found, addresstype)
+		}
+	}
+	if len(found) == 0 {
+		return AddressType{}, ErrorInvalidAddress
+	}
+	if len(found) > 1 {
+		return AddressType{}, ErrorAmbiguousAddress
+	}
+	return found[0], nil
+}
+
+// NetworkOf returns the key of the one of candidates, a map of network names such as "mainnet" and
+// "testnet" to the address type used there, that address decodes with. As map order is random an
+// address matching more than one network gives ErrorAmbiguousAddress, and ErrorInvalidAddress if none.
+func NetworkOf(address string, candidates map[string]AddressType) (string, error) {
+	var found []string
+	for network, addresstype := range candidates {
+		if _, err := AddressDecode(address, addresstype); err == nil {
+			found = append(found, network)
+		}
+	}
+	if len(found) == 0 {
+		return "", ErrorInvalidAddress
+	}
+	if len(found) > 1 {
+		return "", ErrorAmbiguousAddress
+	}
+	return found[0], nil
+}
+
+// DecodeMultiScheme decodes address with the first of schemes, the address types of one coin such as the
+// base58 and bech32 ones of Litecoin, that it decodes with, and returns the hash along with that scheme.
+// ErrorInvalidAddress is returned if none matches.
+func DecodeMultiScheme(address string, schemes []AddressType) ([]byte, AddressType, error) {
+	for _, addresstype := range schemes {
+		if hash, err := AddressDecode(address, addresstype); err == nil {
+			return hash, addresstype, nil
+		}
+	}
+	return nil, AddressType{}, ErrorInvalidAddress
+}
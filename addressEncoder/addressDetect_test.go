@@ -0,0 +1,122 @@
+package addressEncoder
+
+import (
+	"encoding/hex"
+	"reflect"
+	"testing"
+)
+
+func Test_DetectAddressType(t *testing.T) {
+	address := "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"
+	// two overlapping candidates, the BSV legacy addresses share the BTC version bytes;
+	// the hash type plays no part in decoding and tells the two apart here
+	btc := BTC_mainnetAddressP2PKH
+	bsv := BSV_mainnetAddressP2PKH
+	bsv.HashType = "bsv"
+
+	for i := 0; i < 100; i++ {
+		ret, err := DetectAddressType(address, []AddressType{LTC_mainnetAddressP2PKH, btc, bsv})
+		if err != nil || ret.HashType != btc.HashType {
+			t.Fatalf("btc first: got %+v, %v", ret, err)
+		}
+		ret, err = DetectAddressType(address, []AddressType{LTC_mainnetAddressP2PKH, bsv, btc})
+		if err != nil || ret.HashType != bsv.HashType {
+			t.Fatalf("bsv first: got %+v, %v", ret, err)
+		}
+	}
+
+	if _, err := DetectAddressTypeStrict(address, []AddressType{btc, bsv}); err != ErrorAmbiguousAddress {
+		t.Error("ambiguous address not reported:", err)
+	}
+	if ret, err := DetectAddressTypeStrict(address, []AddressType{LTC_mainnetAddressP2PKH, bsv}); err != nil || ret.HashType != bsv.HashType {
+		t.Errorf("single match: got %+v, %v", ret, err)
+	}
+
+	for _, detect := range []func(string, []AddressType) (AddressType, error){DetectAddressType, DetectAddressTypeStrict} {
+		if _, err := detect(address, []AddressType{LTC_mainnetAddressP2PKH, BTC_mainnetAddressP2SH}); err != ErrorInvalidAddress {
+			t.Error("no match:", err)
+		}
+		if _, err := detect(address, nil); err != ErrorInvalidAddress {
+			t.Error("no candidates:", err)
+		}
+	}
+}
+
+func Test_NetworkOf(t *testing.T) {
+	candidates := map[string]AddressType{
+		"mainnet": BTC_mainnetAddressBech32V0,
+		"testnet": BTC_testnetAddressBech32V0,
+	}
+	vectors := map[string]string{
+		"bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4":                     "mainnet",
+		"tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx":                     "testnet",
+		"tb1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3q0sl5k7": "testnet",
+	}
+	for address, want := range vectors {
+		if network, err := NetworkOf(address, candidates); err != nil || network != want {
+			t.Errorf("%s: got %s, %v, want %s", address, network, err, want)
+		}
+	}
+
+	p2wsh := map[string]AddressType{
+		"mainnet": BTC_mainnetAddressP2WSH,
+		"testnet": BTC_testnetAddressP2WSH,
+	}
+	if network, err := NetworkOf("tb1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3q0sl5k7", p2wsh); err != nil || network != "testnet" {
+		t.Errorf("p2wsh: got %s, %v, want testnet", network, err)
+	}
+	if _, err := NetworkOf("ltc1q5c9vun5ctq377nfkznaxlj7nh5a0esm90n89t7", candidates); err != ErrorInvalidAddress {
+		t.Error("litecoin address matched a bitcoin network:", err)
+	}
+	cashaddr := map[string]AddressType{
+		"mainnet": BCH_mainnetAddressCashP2PKH,
+		"testnet": BCH_testnetAddressCashP2PKH,
+	}
+	for address, want := range map[string]string{
+		"bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a": "mainnet",
+		"bchtest:qpm2qsznhks23z7629mms6s4cwef74vcwvqcw003ap":     "testnet",
+	} {
+		if network, err := NetworkOf(address, cashaddr); err != nil || network != want {
+			t.Errorf("%s: got %s, %v, want %s", address, network, err, want)
+		}
+	}
+
+	// BSV legacy addresses share the BTC version bytes
+	shared := map[string]AddressType{"BTC": BTC_mainnetAddressP2PKH, "BSV": BSV_mainnetAddressP2PKH}
+	if _, err := NetworkOf("1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", shared); err != ErrorAmbiguousAddress {
+		t.Error("ambiguous address not reported:", err)
+	}
+}
+
+func Test_DecodeMultiScheme(t *testing.T) {
+	schemes := []AddressType{LTC_mainnetAddressP2PKH, LTC_mainnetAddressP2SH2, LTC_mainnetAddressBech32V0}
+	// one hash in each of the litecoin schemes
+	vectors := []struct {
+		address string
+		scheme  AddressType
+	}{
+		{"LVg2kJoFNg45Nbpy53h7Fe1wKyeXVRhMH9", LTC_mainnetAddressP2PKH},
+		{"MJMEiXPpo2yq1U797tMkDtZWazAQz1kavX", LTC_mainnetAddressP2SH2},
+		{"ltc1qw20apapqpchyuqry6fpzcmlyvgnel3mnt8s4pk", LTC_mainnetAddressBech32V0},
+	}
+	for _, v := range vectors {
+		hash, scheme, err := DecodeMultiScheme(v.address, schemes)
+		if err != nil {
+			t.Errorf("%s: %v", v.address, err)
+			continue
+		}
+		if hex.EncodeToString(hash) != "729fd0f4200e2e4e0064d2422c6fe462279fc773" {
+			t.Errorf("%s: got hash %x", v.address, hash)
+		}
+		if !reflect.DeepEqual(scheme, v.scheme) {
+			t.Errorf("%s: matched %+v, want %+v", v.address, scheme, v.scheme)
+		}
+	}
+
+	if _, _, err := DecodeMultiScheme("1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", schemes); err != ErrorInvalidAddress {
+		t.Error("bitcoin address decoded as litecoin:", err)
+	}
+	if _, _, err := DecodeMultiScheme("LVg2kJoFNg45Nbpy53h7Fe1wKyeXVRhMH9", nil); err != ErrorInvalidAddress {
+		t.Error("no schemes:", err)
+	}
+}
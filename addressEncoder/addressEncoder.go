@@ -1,6 +1,7 @@
 package addressEncoder
 
 import (
+	"bytes"
 	"encoding/hex"
 	"errors"
 	"fmt"
@@ -16,44 +17,120 @@ import (
 var (
 	ErrorInvalidHashLength = errors.New("Invalid hash length!")
 	ErrorInvalidAddress    = errors.New("Invalid address!")
+
+	ErrorUnknownChecksumType   = errors.New("Unknown checksum type!")
+	ErrorInvalidChecksumLength = errors.New("Invalid checksum length!")
 )
 
+// checksumLengths gives the checksum byte length of each checksum type, unknown types use 4 bytes
+var checksumLengths = map[string]int{
+	"doubleSHA256":                       4,
+	"doubleBlake256":                     4,
+	"keccak256":                          4,
+	"sha3_256":                           4,
+	"blake2b_and_keccak256_first_twenty": 4,
+	"ripemd160":                          4,
+	"crc16":                              2,
+	"xor":                                1,
+	"blake2b40_reverse":                  5,
+	"blake2b32":                          4,
+}
+
+func checksumLength(chkType string) int {
+	if l, ok := checksumLengths[chkType]; ok {
+		return l
+	}
+	return 4
+}
+
 // CalcChecksum return calculated checksum
 func CalcChecksum(data []byte, chkType string) []byte {
 	return calcChecksum(data, chkType)
 }
 
-func calcChecksum(data []byte, chkType string) []byte {
+// checksumDigest returns the whole digest a checksum of chkType is taken from
+func checksumDigest(data []byte, chkType string) []byte {
 	if chkType == "doubleSHA256" {
-		return owcrypt.Hash(data, 0, owcrypt.HASH_ALG_DOUBLE_SHA256)[:4]
+		return owcrypt.Hash(data, 0, owcrypt.HASH_ALG_DOUBLE_SHA256)
 	}
 	if chkType == "doubleBlake256" {
-		return blake256.DoubleBlake256(data)[:4]
+		return blake256.DoubleBlake256(data)
 	}
 	if chkType == "keccak256" {
-		return owcrypt.Hash(data, 0, owcrypt.HASH_ALG_KECCAK256)[:4]
+		return owcrypt.Hash(data, 0, owcrypt.HASH_ALG_KECCAK256)
 	}
 	if chkType == "sha3_256" {
-		return owcrypt.Hash(data, 0, owcrypt.HASH_ALG_SHA3_256)[:4]
+		return owcrypt.Hash(data, 0, owcrypt.HASH_ALG_SHA3_256)
 	}
 	if chkType == "blake2b_and_keccak256_first_twenty" {
-		return owcrypt.Hash(owcrypt.Hash(data, 32, owcrypt.HASH_ALG_BLAKE2B), 32, owcrypt.HASH_ALG_KECCAK256)[:4]
+		return owcrypt.Hash(owcrypt.Hash(data, 32, owcrypt.HASH_ALG_BLAKE2B), 32, owcrypt.HASH_ALG_KECCAK256)
 	}
 	if chkType == "ripemd160" {
-		return owcrypt.Hash(data, 0, owcrypt.HASH_ALG_RIPEMD160)[:4]
+		return owcrypt.Hash(data, 0, owcrypt.HASH_ALG_RIPEMD160)
+	}
+	if chkType == "crc16" {
+		// CRC16-XMODEM, little endian as used by stellar
+		crc := crc16(data)
+		return []byte{byte(crc), byte(crc >> 8)}
+	}
+	if chkType == "xor" {
+		var chk byte
+		for _, b := range data {
+			chk ^= b
+		}
+		return []byte{chk}
+	}
+	if chkType == "blake2b32" {
+		return owcrypt.Hash(data, 4, owcrypt.HASH_ALG_BLAKE2B)
+	}
+	if chkType == "blake2b40_reverse" {
+		// nano uses the 5-byte blake2b digest in reverse order
+		hash := owcrypt.Hash(data, 5, owcrypt.HASH_ALG_BLAKE2B)
+		chk := make([]byte, 5)
+		for i := range chk {
+			chk[i] = hash[4-i]
+		}
+		return chk
 	}
 	return nil
 }
 
+func calcChecksum(data []byte, chkType string) []byte {
+	digest := checksumDigest(data, chkType)
+	if digest == nil {
+		return nil
+	}
+	return digest[:checksumLength(chkType)]
+}
+
+// ComputeChecksum returns the first length bytes of the checksum of data for checksumType
+func ComputeChecksum(data []byte, checksumType string, length int) ([]byte, error) {
+	digest := checksumDigest(data, checksumType)
+	if digest == nil {
+		return nil, ErrorUnknownChecksumType
+	}
+	if length <= 0 || length > len(digest) {
+		return nil, ErrorInvalidChecksumLength
+	}
+	return digest[:length], nil
+}
+
 // VerifyChecksum return checksum result
 func VerifyChecksum(data []byte, chkType string) bool {
 	return verifyChecksum(data, chkType)
 }
 
 func verifyChecksum(data []byte, chkType string) bool {
-	checksum := calcChecksum(data[:len(data)-4], chkType)
-	for i := 0; i < 4; i++ {
-		if checksum[i] != data[len(data)-4+i] {
+	l := checksumLength(chkType)
+	if len(data) < l {
+		return false
+	}
+	checksum := calcChecksum(data[:len(data)-l], chkType)
+	if len(checksum) != l {
+		return false
+	}
+	for i := 0; i < l; i++ {
+		if checksum[i] != data[len(data)-l+i] {
 			return false
 		}
 	}
@@ -65,28 +142,19 @@ func CatData(data1 []byte, data2 []byte) []byte {
 	return catData(data1, data2)
 }
 
+// catData always returns a new slice, so that neither input is written through spare capacity.
+// The inputs are often the Prefix and Suffix of a shared AddressType, which may be used concurrently.
 func catData(data1 []byte, data2 []byte) []byte {
-	if data2 == nil {
-		return data1
-	}
-	return append(data1, data2...)
+	ret := make([]byte, len(data1)+len(data2))
+	copy(ret, data1)
+	copy(ret[len(data1):], data2)
+	return ret
 }
 
+// recoverData strips prefix and suffix from data, a nil prefix or suffix works the same as an empty one
 func recoverData(data, prefix, suffix []byte) ([]byte, error) {
-	for i := 0; i < len(prefix); i++ {
-		if data[i] != prefix[i] {
-			return nil, ErrorInvalidAddress
-		}
-	}
-	if suffix != nil {
-		for i := 0; i < len(suffix); i++ {
-			if data[len(data)-len(suffix)+i] != suffix[i] {
-				return nil, ErrorInvalidAddress
-			}
-		}
-	}
-	if suffix == nil {
-		return data[len(prefix):], nil
+	if len(data) < len(prefix)+len(suffix) || !bytes.HasPrefix(data, prefix) || !bytes.HasSuffix(data, suffix) {
+		return nil, ErrorInvalidAddress
 	}
 	return data[len(prefix) : len(data)-len(suffix)], nil
 }
@@ -114,14 +182,18 @@ func decodeData(data, encodeType, alphabet, checkType string, prefix, suffix []b
 		if err != nil {
 			return nil, ErrorInvalidAddress
 		}
-		if verifyChecksum(ret, checkType) == false {
-			return nil, ErrorInvalidAddress
-		}
-		return recoverData(ret[:len(ret)-4], prefix, suffix)
+		return recoverChecked(ret, checkType, prefix, suffix)
 	}
 	return nil, nil
 }
 
+func recoverChecked(data []byte, checkType string, prefix, suffix []byte) ([]byte, error) {
+	if verifyChecksum(data, checkType) == false {
+		return nil, ErrorInvalidAddress
+	}
+	return recoverData(data[:len(data)-checksumLength(checkType)], prefix, suffix)
+}
+
 func calcHash(data []byte, hashType string) []byte {
 	if hashType == "h160" {
 		return owcrypt.Hash(data, 0, owcrypt.HASH_ALG_HASH160)
@@ -129,9 +201,14 @@ func calcHash(data []byte, hashType string) []byte {
 	if hashType == "blake2b160" {
 		return owcrypt.Hash(data, 20, owcrypt.HASH_ALG_BLAKE2B)
 	}
+	if hashType == "blake2b256" {
+		return owcrypt.Hash(data, 32, owcrypt.HASH_ALG_BLAKE2B)
+	}
 	if hashType == "ripemd160" {
 		return owcrypt.Hash(data, 20, owcrypt.HASH_ALG_RIPEMD160)
 	}
+	//the chained hashes apply the first named hash first: keccak256_ripemd160 is ripemd160(keccak256(data))
+	//and sha3_256_ripemd160, used by the nebulas addresses, is ripemd160(sha3_256(data))
 	if hashType == "keccak256_ripemd160" {
 		return owcrypt.Hash(data, 0, owcrypt.HASH_ALG_KECCAK256_RIPEMD160)
 	}
@@ -144,26 +221,121 @@ func calcHash(data []byte, hashType string) []byte {
 	if hashType == "sha3_256_last_twenty" {
 		return owcrypt.Hash(data, 32, owcrypt.HASH_ALG_SHA3_256)[12:32]
 	}
+	if hashType == "sha256_last_twenty" {
+		return owcrypt.Hash(data, 32, owcrypt.HASH_ALG_SHA256)[12:32]
+	}
 	if hashType == "keccak256_last_twenty" {
 		return owcrypt.Hash(data, 32, owcrypt.HASH_ALG_KECCAK256)[12:32]
 	}
 	if hashType == "blake2b_and_keccak256_first_twenty" {
 		return owcrypt.Hash(owcrypt.Hash(data, 32, owcrypt.HASH_ALG_BLAKE2B), 32, owcrypt.HASH_ALG_KECCAK256)[:20]
 	}
+	if hashType == "casper_ed25519" {
+		return owcrypt.Hash(catData([]byte("ed25519\x00"), data), 32, owcrypt.HASH_ALG_BLAKE2B)
+	}
+	if hashType == "casper_secp256k1" {
+		return owcrypt.Hash(catData([]byte("secp256k1\x00"), data), 32, owcrypt.HASH_ALG_BLAKE2B)
+	}
 	return nil
 }
 
+// hashLengths returns the lengths of decoded data addresstype takes, its HashLens or else its HashLen
+func hashLengths(addresstype AddressType) []int {
+	if len(addresstype.HashLens) > 0 {
+		return addresstype.HashLens
+	}
+	return []int{addresstype.HashLen}
+}
+
+// checkLength returns ErrorInvalidHashLength unless got is a length of decoded data addresstype takes:
+// HashLen, or one of HashLens for the types that set it, such as the 20 bytes eip55 keeps of its hash
+// and the 20 and 32 byte cosmos accounts.
+func checkLength(got int, addresstype AddressType) error {
+	for _, l := range hashLengths(addresstype) {
+		if got == l {
+			return nil
+		}
+	}
+	return ErrorInvalidHashLength
+}
+
+// isFinalHash tells if AddressEncode takes hash as it is: it is HashLen bytes long, or one of the
+// HashLens of a type whose PrefixFunc tells the lengths apart.
+func isFinalHash(hash []byte, addresstype AddressType) bool {
+	if addresstype.PrefixFunc != nil {
+		return checkLength(len(hash), addresstype) == nil
+	}
+	return len(hash) == addresstype.HashLen
+}
+
+// truncateHash applies the HashTruncateOffset/HashTruncateLength of addresstype to hash,
+// nil is returned if the range does not fit in hash.
+func truncateHash(hash []byte, addresstype AddressType) []byte {
+	if addresstype.HashTruncateLength == 0 {
+		return hash
+	}
+	offset := addresstype.HashTruncateOffset
+	if offset < 0 {
+		offset += len(hash)
+	}
+	if offset < 0 || addresstype.HashTruncateLength < 0 || offset+addresstype.HashTruncateLength > len(hash) {
+		return nil
+	}
+	return hash[offset : offset+addresstype.HashTruncateLength]
+}
+
+// decodeLongBech32 decodes the bech32 addresses with a program longer than the 40 bytes of BIP-173,
+// whose human readable part must be the one of addresstype.
+func decodeLongBech32(address string, addresstype AddressType) ([]byte, error) {
+	hrp, version, ret, variant, err := bech32.DecodeLong(address, addresstype.Alphabet)
+	if err != nil || hrp != addresstype.ChecksumType {
+		return nil, ErrorInvalidAddress
+	}
+	if version != int(addresstype.Prefix[0]) || variant != bech32VariantOf(addresstype) {
+		return nil, ErrorInvalidAddress
+	}
+	if err := checkLength(len(ret), addresstype); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// bech32VariantOf returns the bech32 checksum variant of addresstype. Unless Bech32Variant is set,
+// it is derived from the witness version: bech32 for version 0, bech32m for version 1 and above.
+func bech32VariantOf(addresstype AddressType) string {
+	if addresstype.Bech32Variant != "" {
+		return addresstype.Bech32Variant
+	}
+	if len(addresstype.Prefix) > 0 && addresstype.Prefix[0] != 0 {
+		return bech32.VariantBech32m
+	}
+	return bech32.VariantBech32
+}
+
+// AddressEncode encodes hash with addresstype. Input of HashLen bytes, or for a type with PrefixFunc set
+// of one of its HashLens, is taken as the hash itself and other input is hashed with the HashType first,
+// so raw data that happens to be that long is never hashed. Use GenerateAddress to always hash and
+// EncodeHash to never hash.
 func AddressEncode(hash []byte, addresstype AddressType) string {
 
 	if addresstype.EncodeType == "bech32" {
-		return bech32.Encode(addresstype.ChecksumType, addresstype.Alphabet, hash, addresstype.Prefix)
+		return bech32.EncodeWithVariant(addresstype.ChecksumType, addresstype.Alphabet, hash, addresstype.Prefix, bech32VariantOf(addresstype))
 	}
 
-	if len(hash) != addresstype.HashLen {
+	if !isFinalHash(hash, addresstype) {
 		hash = calcHash(hash, addresstype.HashType)
+		if addresstype.HashTruncateLength != 0 {
+			if hash = truncateHash(hash, addresstype); hash == nil {
+				return ""
+			}
+		}
 	}
 
 	if addresstype.EncodeType == "base32PolyMod" {
+		//a one byte Prefix is the cashaddr type, otherwise the type leads hash
+		if len(addresstype.Prefix) == 1 {
+			hash = catData(addresstype.Prefix, hash)
+		}
 		return base32PolyMod.Encode(addresstype.ChecksumType, addresstype.Alphabet, hash)
 	}
 	if addresstype.EncodeType == "eip55" {
@@ -172,23 +344,27 @@ func AddressEncode(hash []byte, addresstype AddressType) string {
 	if addresstype.EncodeType == "ICX" {
 		return addresstype.ChecksumType + hex.EncodeToString(hash[:])
 	}
+	if addresstype.EncodeType == "hex" {
+		if len(hash) != addresstype.HashLen {
+			return ""
+		}
+		return addresstype.ChecksumType + hex.EncodeToString(catData(addresstype.Prefix, hash))
+	}
 	if addresstype.EncodeType == "XMR" {
 		if addresstype.HashType == "" {
 			//hash = public spend key(32-byte)||public view key(32 byte),total 64 bytes
 			if len(hash) != 64 {
-				fmt.Println("hash length is error,not 64!!!")
 				return ""
 			}
 		}
 		if addresstype.HashType == "payID" {
 			//hash=public spend key(32 byte)||public view key(32 byte)||payID(8 byte),total 72 bytes
 			if len(hash) != 72 {
-				fmt.Println("hash length is error,not 72!!!")
 				return ""
 			}
 		}
 		//addPrefixHash = Prefix||hash=prxfix || public sepend key||public view key(65-byte)
-		addPrefixHash := append(addresstype.Prefix, hash...)
+		addPrefixHash := catData(addresstype.Prefix, hash)
 		//checksum is the first four bytes of keccak256(addPrefixHash)
 		checksum := owcrypt.Hash(addPrefixHash, 32, owcrypt.HASH_ALG_KECCAK256)[:4]
 		//Suffix checksum addPrefixHash(69-byte)
@@ -230,56 +406,251 @@ func AddressEncode(hash []byte, addresstype AddressType) string {
 		return encodeAE(hash, addresstype)
 	}
 
-	data := catData(catData(addresstype.Prefix, hash), addresstype.Suffix)
-	return encodeData(catData(data, calcChecksum(data, addresstype.ChecksumType)), addresstype.EncodeType, addresstype.Alphabet)
+	if addresstype.EncodeType == "filecoin" {
+		return encodeFIL(hash, addresstype)
+	}
+
+	if addresstype.EncodeType == "iota" {
+		return encodeIOTA(hash, addresstype)
+	}
 
+	if addresstype.EncodeType == "kaspa" {
+		return encodeKAS(hash, addresstype)
+	}
+
+	prefix := addresstype.Prefix
+	if addresstype.PrefixFunc != nil {
+		if prefix = addresstype.PrefixFunc(hash); prefix == nil {
+			return ""
+		}
+	}
+	input, err := checksumInput(prefix, hash, addresstype.Suffix, addresstype.ChecksumScope)
+	if err != nil {
+		return ""
+	}
+	data := catData(catData(prefix, hash), addresstype.Suffix)
+	return encodeData(catData(data, calcChecksum(input, addresstype.ChecksumType)), addresstype.EncodeType, addresstype.Alphabet)
+
+}
+
+// EncodeScriptHash encodes the address of script, such as a P2SH redeem script, hashed with the
+// HashType of addresstype. Unlike AddressEncode, script is always hashed, even when its length
+// happens to be HashLen. An empty string is returned if the hash type yields no HashLen bytes.
+func EncodeScriptHash(script []byte, addresstype AddressType) string {
+	hash := calcHash(script, addresstype.HashType)
+	if addresstype.HashTruncateLength != 0 {
+		hash = truncateHash(hash, addresstype)
+	}
+	if hash == nil || len(hash) != addresstype.HashLen {
+		return ""
+	}
+	return AddressEncode(hash, addresstype)
+}
+
+// GenerateAddress is the entry point to get the address of a public key: it hashes pubkey with the
+// HashType of addresstype and encodes the hash. Unlike AddressEncode, which takes an input of HashLen
+// bytes as the hash itself, pubkey is always hashed, for the bech32 types too. Types without a hash
+// type, such as taproot or monero, encode pubkey as it is.
+func GenerateAddress(pubkey []byte, addresstype AddressType) (string, error) {
+	hash := calcHash(pubkey, addresstype.HashType)
+	if hash == nil {
+		hash = pubkey
+	} else {
+		hash = truncateHash(hash, addresstype)
+	}
+	if hash == nil || len(hash) != addresstype.HashLen {
+		return "", ErrorInvalidHashLength
+	}
+	address := AddressEncode(hash, addresstype)
+	if address == "" {
+		return "", ErrorInvalidAddress
+	}
+	return address, nil
+}
+
+// EncodeHash is the counterpart of GenerateAddress: hash is always taken as the final hash and never
+// hashed, whatever its length. ErrorInvalidHashLength is returned unless it has a length AddressDecode
+// gives back for addresstype, such as the 20 bytes of an eip55 address.
+func EncodeHash(hash []byte, addresstype AddressType) (string, error) {
+	if err := checkLength(len(hash), addresstype); err != nil {
+		return "", err
+	}
+	var address string
+	if addresstype.EncodeType == "eip55" {
+		// the same form AddressEncode gives, which cuts the 20 bytes from the keccak256 itself
+		address = hex.EncodeToString(hash)
+	} else {
+		address = AddressEncode(hash, addresstype)
+	}
+	if address == "" {
+		return "", ErrorInvalidAddress
+	}
+	return address, nil
+}
+
+// withVersion returns a copy of addresstype carrying version as its Prefix, which then also holds for decoding
+func withVersion(version []byte, addresstype AddressType) AddressType {
+	ret := addresstype.WithPrefix(version)
+	ret.PrefixLen = nil
+	ret.PrefixFunc = nil
+	return ret
+}
+
+// AddressEncodeWithVersion works like EncodeHash with version in place of the Prefix of addresstype, for a
+// network byte that is only known at call time such as a configured one or a multisig variant.
+func AddressEncodeWithVersion(hash []byte, version []byte, addresstype AddressType) (string, error) {
+	return EncodeHash(hash, withVersion(version, addresstype))
+}
+
+// AddressDecodeWithVersion is the counterpart of AddressEncodeWithVersion, it works like AddressDecode
+// with version in place of the Prefix of addresstype. An address of another version does not decode.
+func AddressDecodeWithVersion(address string, version []byte, addresstype AddressType) ([]byte, error) {
+	return AddressDecode(address, withVersion(version, addresstype))
+}
+
+// AddressToHex returns the lower case hex of the data address decodes to with addresstype, without
+// prefix or checksum, the form to store and index addresses by.
+func AddressToHex(address string, addresstype AddressType) (string, error) {
+	data, err := AddressDecode(address, addresstype)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(data), nil
+}
+
+// HexToAddress is the inverse of AddressToHex, it encodes the hash given in hex with addresstype.
+// The hash is taken as EncodeHash takes it, so it must have a length AddressDecode gives back.
+func HexToAddress(hexHash string, addresstype AddressType) (string, error) {
+	hash, err := hex.DecodeString(hexHash)
+	if err != nil {
+		return "", ErrorInvalidAddress
+	}
+	return EncodeHash(hash, addresstype)
+}
+
+// bech32HRPMatches reports whether the human readable part of the bech32 address is hrp, in either case
+func bech32HRPMatches(address, hrp string) bool {
+	pos := strings.LastIndexByte(address, '1')
+	return pos > 0 && strings.EqualFold(address[:pos], hrp)
 }
 
 func AddressDecode(address string, addresstype AddressType) ([]byte, error) {
+	// eip55 is left out for the ENS names, which may be unicode
+	if addresstype.EncodeType != "eip55" {
+		if err := checkASCII(address); err != nil {
+			return nil, err
+		}
+	}
+	address, err := foldCase(address, caseFoldOf(addresstype))
+	if err != nil {
+		return nil, err
+	}
 	if addresstype.EncodeType == "bech32" {
-		ret, err := bech32.Decode(address, addresstype.Alphabet)
+		if !bech32HRPMatches(address, addresstype.ChecksumType) {
+			return nil, ErrorInvalidAddress
+		}
+		if len(addresstype.Prefix) == 0 {
+			ret, err := bech32.Decode(address, addresstype.Alphabet)
+			if err != nil {
+				return nil, ErrorInvalidAddress
+			}
+			if err := checkLength(len(ret), addresstype); err != nil {
+				return nil, err
+			}
+			return ret, nil
+		}
+		if addresstype.HashLen > 40 {
+			return decodeLongBech32(address, addresstype)
+		}
+		version, ret, variant, err := bech32.DecodeWithVersion(address, addresstype.Alphabet)
 		if err != nil {
 			return nil, ErrorInvalidAddress
 		}
-		if len(ret) != 20 && len(ret) != 32 {
-			return nil, ErrorInvalidHashLength
+		if version != int(addresstype.Prefix[0]) || variant != bech32VariantOf(addresstype) {
+			return nil, ErrorInvalidAddress
+		}
+		if err := checkLength(len(ret), addresstype); err != nil {
+			return nil, err
 		}
 		return ret, nil
 	}
 	if addresstype.EncodeType == "base32PolyMod" {
+		//an address without prefix takes the one of addresstype, another prefix is rejected as it is only
+		//covered by the checksum and a testnet address is as valid as a mainnet one without this
+		if !strings.Contains(address, ":") {
+			address = strings.ToLower(addresstype.ChecksumType) + ":" + address
+		}
+		if !strings.HasPrefix(strings.ToLower(address), strings.ToLower(addresstype.ChecksumType)+":") {
+			return nil, ErrorInvalidAddress
+		}
 		ret, err := base32PolyMod.Decode(address, addresstype.Alphabet)
+		if err == base32PolyMod.ErrorSizeMismatch {
+			return nil, ErrorInvalidHashLength
+		}
 		if err != nil {
 			return nil, ErrorInvalidAddress
 		}
-		if len(ret) != addresstype.HashLen {
-			return nil, ErrorInvalidHashLength
+		if len(addresstype.Prefix) == 1 {
+			if ret[0]>>3 != addresstype.Prefix[0] {
+				return nil, ErrorInvalidAddress
+			}
+			ret = ret[1:]
+		}
+		if err := checkLength(len(ret), addresstype); err != nil {
+			return nil, err
 		}
 		return ret, nil
 	}
 	if addresstype.EncodeType == "eip55" {
-		ret, err := eip55.Eip55_decode(address)
+		if IsENSName(address) {
+			return nil, ErrorENSName
+		}
+		//eip55.Eip55_decode slices off a 0x it does not check for, which panics on input shorter than 2
+		ret, err := hex.DecodeString(strings.TrimPrefix(address, "0x"))
 		if err != nil {
 			return nil, ErrorInvalidAddress
 		}
-		if len(ret) != 20 {
-			return nil, ErrorInvalidHashLength
+		if err := checkLength(len(ret), addresstype); err != nil {
+			return nil, err
 		}
 		return ret, nil
 	}
 	if addresstype.EncodeType == "ICX" {
-		if address[0] != 'h' || address[1] != 'x' {
+		if !strings.HasPrefix(address, "hx") {
 			return nil, ErrorInvalidAddress
 		} else {
-			if len(address)-2 != 40 {
-				return nil, ErrorInvalidHashLength
-			} else {
-				ret, err := hex.DecodeString(address[2:])
-				if err != nil {
-					return nil, err
-				}
-				return ret, nil
+			if len(address)%2 != 0 {
+				return nil, ErrorOddHexLength
+			}
+			ret, err := hex.DecodeString(address[2:])
+			if err != nil {
+				return nil, err
+			}
+			if err := checkLength(len(ret), addresstype); err != nil {
+				return nil, err
 			}
+			return ret, nil
+		}
+	}
+	if addresstype.EncodeType == "hex" {
+		if !strings.HasPrefix(address, addresstype.ChecksumType) {
+			return nil, ErrorInvalidAddress
 		}
+		if (len(address)-len(addresstype.ChecksumType))%2 != 0 {
+			return nil, ErrorOddHexLength
+		}
+		data, err := hex.DecodeString(address[len(addresstype.ChecksumType):])
+		if err != nil {
+			return nil, ErrorInvalidAddress
+		}
+		ret, err := recoverData(data, addresstype.Prefix, nil)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkLength(len(ret), addresstype); err != nil {
+			return nil, err
+		}
+		return ret, nil
 	}
 	if addresstype.EncodeType == "XMR" {
 		if addresstype.HashType == "" {
@@ -299,7 +670,6 @@ func AddressDecode(address string, addresstype AddressType) ([]byte, error) {
 			blockAddr := address[i*11 : (i+1)*11]
 			blockDecode, err := Base58Decode(blockAddr, NewBase58Alphabet(addresstype.Alphabet))
 			if err != nil {
-				fmt.Printf("Base58 decode failed,block:%d,unexpected error:%v", i+1, err)
 				return nil, err
 			}
 			if len(blockDecode) < 8 {
@@ -310,7 +680,6 @@ func AddressDecode(address string, addresstype AddressType) ([]byte, error) {
 		}
 		lastBlockDecode, err := Base58Decode(address[(cycle*11):(cycle*11+remainder)], NewBase58Alphabet(addresstype.Alphabet))
 		if err != nil {
-			fmt.Printf("The last block decode failed,unexpected error:%v", err)
 			return nil, err
 		}
 		if len(lastBlockDecode) < 5 {
@@ -319,12 +688,13 @@ func AddressDecode(address string, addresstype AddressType) ([]byte, error) {
 			decodeRet = append(decodeRet, lastBlockDecode[len(lastBlockDecode)-5:]...)
 		}
 		if verifyChecksum(decodeRet, addresstype.ChecksumType) == false {
-			fmt.Printf("verify address checksum failed!!!")
 			return nil, ErrorInvalidAddress
 		}
-		ret, err := recoverData(decodeRet[:len(decodeRet)-4], addresstype.Prefix, addresstype.Suffix)
+		ret, err := recoverData(decodeRet[:len(decodeRet)-checksumLength(addresstype.ChecksumType)], addresstype.Prefix, addresstype.Suffix)
 		if err != nil {
-			fmt.Printf("recover data failed!!!")
+			return nil, err
+		}
+		if err := checkLength(len(ret), addresstype); err != nil {
 			return nil, err
 		}
 		return ret, nil
@@ -338,12 +708,118 @@ func AddressDecode(address string, addresstype AddressType) ([]byte, error) {
 		return decodeAE(address, addresstype)
 	}
 
-	data, err := decodeData(address, addresstype.EncodeType, addresstype.Alphabet, addresstype.ChecksumType, addresstype.Prefix, addresstype.Suffix)
+	if addresstype.EncodeType == "filecoin" {
+		return decodeFIL(address, addresstype)
+	}
+
+	if addresstype.EncodeType == "iota" {
+		return decodeIOTA(address, addresstype)
+	}
+
+	if addresstype.EncodeType == "kaspa" {
+		return decodeKAS(address, addresstype)
+	}
+
+	if addresstype.EncodeType == "base58" {
+		_, data, err := AddressDecodePrefix(address, addresstype)
+		return data, err
+	}
+
+	//no decoder for the encode type
+	return nil, ErrorInvalidAddress
+}
+
+// AddressDecodeBytes works like AddressDecode for an address held in a byte slice.
+// Base58 addresses are decoded from the slice directly, other encodings are converted to string.
+func AddressDecodeBytes(address []byte, addresstype AddressType) ([]byte, error) {
+	if addresstype.EncodeType != "base58" {
+		return AddressDecode(string(address), addresstype)
+	}
+	decoded, err := Base58DecodeBytes(address, NewBase58Alphabet(addresstype.Alphabet))
 	if err != nil {
-		return nil, err
+		return nil, ErrorInvalidAddress
 	}
-	if len(data) != addresstype.HashLen {
-		return nil, ErrorInvalidHashLength
+	_, data, err := splitChecked(decoded, addresstype)
+	return data, err
+}
+
+// splitChecked verifies the checksum of a decoded base58check address and splits it into its prefix and data.
+// The prefix is the one of addresstype, or as long as PrefixLen tells from the leading bytes when that is set.
+func splitChecked(decoded []byte, addresstype AddressType) ([]byte, []byte, error) {
+	if addresstype.PrefixFunc != nil {
+		return splitPayloadPrefix(decoded, addresstype)
+	}
+	prefix := addresstype.Prefix
+	if addresstype.PrefixLen != nil {
+		n := addresstype.PrefixLen(decoded)
+		if n < 0 || n > len(decoded)-checksumLength(addresstype.ChecksumType) {
+			return nil, nil, ErrorInvalidAddress
+		}
+		prefix = decoded[:n]
+	}
+	//the lengths are fixed by addresstype, a truncated address is told apart from a mistyped one before the checksum
+	if checkLength(len(decoded)-len(prefix)-len(addresstype.Suffix)-checksumLength(addresstype.ChecksumType), addresstype) != nil {
+		return nil, nil, ErrorInvalidHashLength
+	}
+	body := decoded[:len(decoded)-checksumLength(addresstype.ChecksumType)]
+	data, err := recoverData(body, prefix, addresstype.Suffix)
+	if err != nil {
+		return nil, nil, err
+	}
+	input, err := checksumInput(prefix, data, addresstype.Suffix, addresstype.ChecksumScope)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !bytes.Equal(calcChecksum(input, addresstype.ChecksumType), decoded[len(body):]) {
+		return nil, nil, ErrorInvalidAddress
+	}
+	if err := checkLength(len(data), addresstype); err != nil {
+		return nil, nil, err
+	}
+	return prefix, data, nil
+}
+
+// splitPayloadPrefix is splitChecked for an addresstype with PrefixFunc set: the prefix is the leading bytes
+// that PrefixFunc gives for the data after them, the shortest such split is taken.
+func splitPayloadPrefix(decoded []byte, addresstype AddressType) ([]byte, []byte, error) {
+	bodyLen := len(decoded) - checksumLength(addresstype.ChecksumType)
+	end := bodyLen - len(addresstype.Suffix)
+	if end < 0 || !bytes.HasSuffix(decoded[:bodyLen], addresstype.Suffix) {
+		return nil, nil, ErrorInvalidAddress
+	}
+	for n := 0; n <= end; n++ {
+		prefix, data := decoded[:n], decoded[n:end]
+		if want := addresstype.PrefixFunc(data); want == nil || !bytes.Equal(want, prefix) {
+			continue
+		}
+		input, err := checksumInput(prefix, data, addresstype.Suffix, addresstype.ChecksumScope)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !bytes.Equal(calcChecksum(input, addresstype.ChecksumType), decoded[bodyLen:]) {
+			return nil, nil, ErrorInvalidAddress
+		}
+		if err := checkLength(len(data), addresstype); err != nil {
+			return nil, nil, err
+		}
+		return prefix, data, nil
+	}
+	return nil, nil, ErrorInvalidAddress
+}
+
+// AddressDecodePrefix works like AddressDecode and also returns the version prefix the address carries,
+// which for an addresstype with PrefixLen set may have a different width from one address to another.
+func AddressDecodePrefix(address string, addresstype AddressType) ([]byte, []byte, error) {
+	if addresstype.EncodeType != "base58" {
+		data, err := AddressDecode(address, addresstype)
+		if err != nil {
+			return nil, nil, err
+		}
+		return addresstype.Prefix, data, nil
+	}
+	decoded, err := Base58Decode(address, NewBase58Alphabet(addresstype.Alphabet))
+	if err != nil {
+		return nil, nil, ErrorInvalidAddress
 	}
-	return data, nil
+	return splitChecked(decoded, addresstype)
 }
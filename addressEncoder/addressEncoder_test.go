@@ -1,11 +1,19 @@
 package addressEncoder
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"encoding/base64"
 	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
+	"sync"
 	"testing"
 
+	"github.com/blocktree/go-owcdrivers/addressEncoder/bech32"
+	"github.com/blocktree/go-owcdrivers/addressEncoder/blake256"
 	"github.com/blocktree/go-owcrypt"
 )
 
@@ -1175,4 +1183,1045 @@ func Test_wicc_address(t *testing.T) {
 	testnet_addr = AddressEncode(hash, WICC_testnetAddressP2PKH)
 
 	fmt.Println(testnet_addr)
-}
\ No newline at end of file
+}
+
+func Test_DecodeTrimmed(t *testing.T) {
+	hash, _ := hex.DecodeString("6231f1005e86c03d5fbd41776985d094ccb682d3")
+
+	for _, addr := range []string{
+		"  19xD3nnvEiu7Uqd8irRvF3j5ExLb4ZtSju  ",
+		"\t19xD3nnvEiu7Uqd8irRvF3j5ExLb4ZtSju\r\n",
+		"\u200b19xD3nnvEiu7Uqd8irRvF3j5ExLb4ZtSju\ufeff",
+	} {
+		chk, stripped, err := DecodeTrimmed(addr, BTC_mainnetAddressP2PKH)
+		if err != nil || !stripped {
+			t.Errorf("decode %q: got %v, stripped %v", addr, err, stripped)
+			continue
+		}
+		if hex.EncodeToString(chk) != hex.EncodeToString(hash) {
+			t.Errorf("decode %q wrong result: %x", addr, chk)
+		}
+	}
+
+	if chk, stripped, err := DecodeTrimmed("19xD3nnvEiu7Uqd8irRvF3j5ExLb4ZtSju", BTC_mainnetAddressP2PKH); err != nil || stripped || hex.EncodeToString(chk) != hex.EncodeToString(hash) {
+		t.Errorf("clean address decoded %x, stripped %v, %v", chk, stripped, err)
+	}
+	if _, _, err := DecodeTrimmed(" 19xD3nnvEiu7Uqd8irRvF3j5ExLb4ZtSjv", BTC_mainnetAddressP2PKH); err == nil {
+		t.Error("bad checksum accepted after trimming")
+	}
+
+	_, _, err := DecodeTrimmed("19xD3nnvEiu7Uqd8i\nrRvF3j5ExLb4ZtSju", BTC_mainnetAddressP2PKH)
+	if err != ErrorInvalidCharacter {
+		t.Errorf("embedded newline should be rejected with ErrorInvalidCharacter, got: %v", err)
+	}
+
+	if trimmed, stripped, err := TrimAddress(" bc1qar0srrr7xfkvy5l643lydnw9re59gtzzwf5mdq\n"); err != nil || !stripped || trimmed != "bc1qar0srrr7xfkvy5l643lydnw9re59gtzzwf5mdq" {
+		t.Errorf("TrimAddress: got %q, stripped %v, %v", trimmed, stripped, err)
+	}
+	if _, stripped, err := TrimAddress("bc1qar0srrr7xfkvy5l643lydnw9re59gtzzwf5mdq"); err != nil || stripped {
+		t.Errorf("TrimAddress of a clean address: stripped %v, %v", stripped, err)
+	}
+}
+
+func Test_bech32m_v0_address(t *testing.T) {
+	hash, _ := hex.DecodeString("6231f1005e86c03d5fbd41776985d094ccb682d3")
+
+	address := AddressEncode(hash, BGL_mainnetAddressBech32V0)
+	if address != "bgl1qvgclzqz7smqr6haag9mknpwsjnxtdqknw38u9t" {
+		t.Errorf("bgl address encode wrong result: %s", address)
+	}
+
+	chk, err := AddressDecode(address, BGL_mainnetAddressBech32V0)
+	if err != nil || hex.EncodeToString(chk) != hex.EncodeToString(hash) {
+		t.Errorf("bgl address decode failed: %v", err)
+	}
+
+	// the same program checksummed as bech32 must not be accepted
+	_, err = AddressDecode("bgl1qvgclzqz7smqr6haag9mknpwsjnxtdqknmdhsqf", BGL_mainnetAddressBech32V0)
+	if err == nil {
+		t.Error("bech32 checksum should be rejected by a bech32m preset")
+	}
+
+	// bitcoin keeps the version derived variant
+	_, err = AddressDecode("bc1qvgclzqz7smqr6haag9mknpwsjnxtdqkncr64kd", BTC_mainnetAddressBech32V0)
+	if err != nil {
+		t.Errorf("btc bech32 v0 decode failed: %v", err)
+	}
+}
+
+func Test_checksumLength(t *testing.T) {
+	data := []byte("123456789")
+	for chkType, l := range checksumLengths {
+		chk := calcChecksum(data, chkType)
+		if len(chk) != l {
+			t.Errorf("%s checksum length %d, want %d", chkType, len(chk), l)
+			continue
+		}
+		if !verifyChecksum(catData(data, chk), chkType) {
+			t.Errorf("%s checksum verify failed", chkType)
+		}
+		encoded := encodeData(catData(catData([]byte{0x01}, data), calcChecksum(catData([]byte{0x01}, data), chkType)), "base58", BTCAlphabet)
+		decoded, err := decodeData(encoded, "base58", BTCAlphabet, chkType, []byte{0x01}, nil)
+		if err != nil || string(decoded) != string(data) {
+			t.Errorf("%s checksum decode failed: %x, %v", chkType, decoded, err)
+		}
+	}
+
+	expect := map[string]string{
+		"crc16":             "c331",
+		"xor":               "31",
+		"blake2b40_reverse": "bfc2d946d7",
+	}
+	for chkType, chk := range expect {
+		if ret := hex.EncodeToString(calcChecksum(data, chkType)); ret != chk {
+			t.Errorf("%s checksum %s, want %s", chkType, ret, chk)
+		}
+	}
+}
+
+func Test_AddressDecodeBytes(t *testing.T) {
+	for _, address := range []string{"19xD3nnvEiu7Uqd8irRvF3j5ExLb4ZtSju", "bc1qvgclzqz7smqr6haag9mknpwsjnxtdqkncr64kd"} {
+		addresstype := BTC_mainnetAddressP2PKH
+		if strings.HasPrefix(address, "bc1") {
+			addresstype = BTC_mainnetAddressBech32V0
+		}
+		expect, _ := AddressDecode(address, addresstype)
+		ret, err := AddressDecodeBytes([]byte(address), addresstype)
+		if err != nil || hex.EncodeToString(ret) != hex.EncodeToString(expect) {
+			t.Errorf("decode %s from bytes failed: %x, %v", address, ret, err)
+		}
+	}
+	if _, err := AddressDecodeBytes([]byte("19xD3nnvEiu7Uqd8irRvF3j5ExLb4ZtSjv"), BTC_mainnetAddressP2PKH); err == nil {
+		t.Error("bad checksum should be rejected")
+	}
+}
+
+var benchAddress = []byte("19xD3nnvEiu7Uqd8irRvF3j5ExLb4ZtSju")
+
+func Benchmark_AddressDecode_string(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		AddressDecode(string(benchAddress), BTC_mainnetAddressP2PKH)
+	}
+}
+
+func Benchmark_AddressDecodeBytes(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		AddressDecodeBytes(benchAddress, BTC_mainnetAddressP2PKH)
+	}
+}
+
+// benchTypes are representative inputs of each encode type for the AddressEncode and AddressDecode benchmarks
+var benchTypes = []struct {
+	name        string
+	hash        string
+	addresstype AddressType
+}{
+	{"base58", "751e76e8199196d454941c45d1b3a323f1433bd6", BTC_mainnetAddressP2PKH},
+	{"bech32", "751e76e8199196d454941c45d1b3a323f1433bd6", BTC_mainnetAddressBech32V0},
+	{"bech32m", "79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798", BTC_mainnetAddressTaproot},
+	{"base32PolyMod", "00751e76e8199196d454941c45d1b3a323f1433bd6", BCH_mainnetAddressCash},
+	{"eip55", "9a1c0ba81eb126e1b4e9d3cba9812d1aa2c955a8a344074c2b1a0bd27ae7cf43", ETH_mainnetPublicAddress},
+}
+
+func Benchmark_AddressEncode(b *testing.B) {
+	for _, bt := range benchTypes {
+		hash, _ := hex.DecodeString(bt.hash)
+		addresstype := bt.addresstype
+		b.Run(bt.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				AddressEncode(hash, addresstype)
+			}
+		})
+	}
+}
+
+func Benchmark_AddressDecode(b *testing.B) {
+	for _, bt := range benchTypes {
+		hash, _ := hex.DecodeString(bt.hash)
+		addresstype := bt.addresstype
+		address := AddressEncode(hash, addresstype)
+		if _, err := AddressDecode(address, addresstype); err != nil {
+			b.Fatalf("%s: decode %s failed: %v", bt.name, address, err)
+		}
+		b.Run(bt.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				AddressDecode(address, addresstype)
+			}
+		})
+	}
+}
+
+func Test_ComputeChecksum(t *testing.T) {
+	data := []byte("123456789")
+	expect := map[string]string{
+		"doubleSHA256":                       "292b0d007566832d",
+		"doubleBlake256":                     "d6daf85e072a3cea",
+		"keccak256":                          "2a359feeb8e488a1",
+		"sha3_256":                           "87cd084d190e436f",
+		"blake2b_and_keccak256_first_twenty": "88b28ed7e3145ee8",
+		"ripemd160":                          "d3d0379126c1e5e0",
+	}
+	for chkType, chk := range expect {
+		ret, err := ComputeChecksum(data, chkType, 8)
+		if err != nil || hex.EncodeToString(ret) != chk {
+			t.Errorf("%s checksum %x, want %s", chkType, ret, chk)
+		}
+		ret, err = ComputeChecksum(data, chkType, checksumLength(chkType))
+		if err != nil || hex.EncodeToString(ret) != hex.EncodeToString(calcChecksum(data, chkType)) {
+			t.Errorf("%s checksum does not match calcChecksum", chkType)
+		}
+	}
+
+	if ret, err := ComputeChecksum(data, "crc16", 2); err != nil || hex.EncodeToString(ret) != "c331" {
+		t.Errorf("crc16 checksum %x, want c331", ret)
+	}
+	if _, err := ComputeChecksum(data, "crc16", 3); err != ErrorInvalidChecksumLength {
+		t.Error("crc16 checksum can not be longer than 2 bytes")
+	}
+	if _, err := ComputeChecksum(data, "doubleSHA256", 0); err != ErrorInvalidChecksumLength {
+		t.Error("zero length checksum should be rejected")
+	}
+	if _, err := ComputeChecksum(data, "sha1", 4); err != ErrorUnknownChecksumType {
+		t.Error("unknown checksum type should be rejected")
+	}
+}
+
+func Test_truncateHash(t *testing.T) {
+	pubkey, _ := hex.DecodeString("0451e9aaaa1643f4f13570cb16324ba9345670aac603b7510f9334c1dcd0123b0a265b2c2b0ec5227a89f865c155fc2fe3422f2a474ba091ef4106f113b687f053")
+	digest := calcHash(pubkey, "keccak256")
+
+	last := AddressType{HashType: "keccak256", HashTruncateOffset: 12, HashTruncateLength: 20}
+	lastFromEnd := AddressType{HashType: "keccak256", HashTruncateOffset: -20, HashTruncateLength: 20}
+	first := AddressType{HashType: "keccak256", HashTruncateLength: 20}
+
+	if ret := truncateHash(digest, last); hex.EncodeToString(ret) != hex.EncodeToString(calcHash(pubkey, "keccak256_last_twenty")) {
+		t.Errorf("last twenty wrong: %x", ret)
+	}
+	if ret := truncateHash(digest, lastFromEnd); hex.EncodeToString(ret) != hex.EncodeToString(digest[12:]) {
+		t.Errorf("last twenty counted from the end wrong: %x", ret)
+	}
+	if ret := truncateHash(digest, first); hex.EncodeToString(ret) != hex.EncodeToString(digest[:20]) {
+		t.Errorf("first twenty wrong: %x", ret)
+	}
+	if ret := truncateHash(digest, AddressType{HashTruncateOffset: 20, HashTruncateLength: 20}); ret != nil {
+		t.Errorf("out of range truncation should give nil: %x", ret)
+	}
+
+	// TRON expressed with a truncation instead of the keccak256_last_twenty branch
+	tron := TRON_mainnetAddress
+	tron.HashType = "keccak256"
+	tron.HashTruncateOffset = 12
+	tron.HashTruncateLength = 20
+	if AddressEncode(pubkey[1:], tron) != AddressEncode(pubkey[1:], TRON_mainnetAddress) {
+		t.Error("truncated keccak256 should match keccak256_last_twenty")
+	}
+}
+
+// Test_hashAlgorithmMapping pins each hashType/checksumType name to the owcrypt algorithm it should use,
+// the expected digests come from the go stdlib or were computed with independent implementations.
+func Test_hashAlgorithmMapping(t *testing.T) {
+	data := []byte("abc")
+
+	hashes := map[string]string{
+		"h160":                               "bb1be98c142444d7a56aa3981c3942a978e4dc33",
+		"blake2b160":                         "384264f676f39536840523f284921cdc68b6846b",
+		"blake2b256":                         "bddd813c634239723171ef3fee98579b94964e3bb1cb3e427262c8c068d52319",
+		"ripemd160":                          "8eb208f7e05d987a9b044a8e98c6b087f15a0bfc",
+		"keccak256_ripemd160":                "aa661f0717409be4e9bb86e3589dabe5d4a4276a",
+		"sha3_256_ripemd160":                 "311e8ffbbbcbf1bbec6d11d0cce46f205f1bc146",
+		"keccak256":                          "4e03657aea45a94fc7d47ba826c8d667c0d1e6e33a64a036ec44f58fa12d6c45",
+		"sha3_256_last_twenty":               "6bd390bd855f086e3e9d525b46bfe24511431532",
+		"sha256_last_twenty":                 "5dae2223b00361a396177a9cb410ff61f20015ad",
+		"keccak256_last_twenty":              "26c8d667c0d1e6e33a64a036ec44f58fa12d6c45",
+		"blake2b_and_keccak256_first_twenty": "96d996fb20ae684d5ad45107cc28723d6a846a2f",
+	}
+	for hashType, expect := range hashes {
+		if ret := hex.EncodeToString(calcHash(data, hashType)); ret != expect {
+			t.Errorf("%s hash %s, want %s", hashType, ret, expect)
+		}
+	}
+
+	first := sha256.Sum256(data)
+	second := sha256.Sum256(first[:])
+	checksums := map[string]string{
+		"doubleSHA256":                       hex.EncodeToString(second[:4]),
+		"keccak256":                          "4e03657a",
+		"sha3_256":                           "3a985da7",
+		"blake2b_and_keccak256_first_twenty": "96d996fb",
+		"ripemd160":                          "8eb208f7",
+		"blake2b40_reverse":                  "efc09f2244",
+		"blake2b32":                          "63906248",
+	}
+	for chkType, expect := range checksums {
+		if ret := hex.EncodeToString(calcChecksum(data, chkType)); ret != expect {
+			t.Errorf("%s checksum %s, want %s", chkType, ret, expect)
+		}
+	}
+
+	// blake256 is not in owcrypt, check the package against the BLAKE-256 reference vectors
+	for input, expect := range map[string]string{
+		"00":                                 "0ce8d4ef4dd7cd8d62dfded9d4edb0a774ae6a41929a74da23109e8f11139c87",
+		hex.EncodeToString(make([]byte, 72)): "d419bad32d504fb7d44d460c42c5593fe544fa4c135dec31e21bd9abdcc22d41",
+	} {
+		in, _ := hex.DecodeString(input)
+		h := blake256.New()
+		h.Write(in)
+		if ret := hex.EncodeToString(h.Sum(nil)); ret != expect {
+			t.Errorf("blake256 of %s is %s, want %s", input, ret, expect)
+		}
+	}
+	h := blake256.New()
+	h.Write(data)
+	h2 := blake256.New()
+	h2.Write(h.Sum(nil))
+	if ret, expect := calcChecksum(data, "doubleBlake256"), h2.Sum(nil)[:4]; hex.EncodeToString(ret) != hex.EncodeToString(expect) {
+		t.Errorf("doubleBlake256 checksum %x, want %x", ret, expect)
+	}
+}
+
+func Test_variablePrefixLen(t *testing.T) {
+	hash, _ := hex.DecodeString("6231f1005e86c03d5fbd41776985d094ccb682d3")
+	short := AddressType{EncodeType: "base58", Alphabet: BTCAlphabet, ChecksumType: "doubleSHA256", HashType: "h160", HashLen: 20, Prefix: []byte{0x3A}}
+	long := AddressType{EncodeType: "base58", Alphabet: BTCAlphabet, ChecksumType: "doubleSHA256", HashType: "h160", HashLen: 20, Prefix: []byte{0x1C, 0xB8}}
+
+	// 0x1C starts the two byte versions, everything else is a single byte
+	either := short
+	either.Prefix = nil
+	either.PrefixLen = func(data []byte) int {
+		if len(data) > 0 && data[0] == 0x1C {
+			return 2
+		}
+		return 1
+	}
+
+	for _, at := range []AddressType{short, long} {
+		address := AddressEncode(hash, at)
+		prefix, ret, err := AddressDecodePrefix(address, either)
+		if err != nil || hex.EncodeToString(prefix) != hex.EncodeToString(at.Prefix) || hex.EncodeToString(ret) != hex.EncodeToString(hash) {
+			t.Errorf("decode %s: prefix %x, hash %x, %v", address, prefix, ret, err)
+		}
+		if ret, err = AddressDecode(address, either); err != nil || hex.EncodeToString(ret) != hex.EncodeToString(hash) {
+			t.Errorf("AddressDecode %s: %x, %v", address, ret, err)
+		}
+		if ret, err = AddressDecodeBytes([]byte(address), either); err != nil || hex.EncodeToString(ret) != hex.EncodeToString(hash) {
+			t.Errorf("AddressDecodeBytes %s: %x, %v", address, ret, err)
+		}
+	}
+
+	// without PrefixLen the two byte version leaves a 21 byte hash
+	if _, err := AddressDecode(AddressEncode(hash, long), AddressType{EncodeType: "base58", Alphabet: BTCAlphabet, ChecksumType: "doubleSHA256", HashLen: 20, Prefix: []byte{0x1C}}); err != ErrorInvalidHashLength {
+		t.Errorf("fixed prefix should not split a two byte version: %v", err)
+	}
+}
+
+func Test_PrefixFunc(t *testing.T) {
+	// the version byte tells a 20-byte key hash from a 32-byte script hash
+	bySize := AddressType{EncodeType: "base58", Alphabet: BTCAlphabet, ChecksumType: "doubleSHA256", HashType: "h160", HashLen: 20, HashLens: []int{20, 32}}
+	bySize.PrefixFunc = func(payload []byte) []byte {
+		switch len(payload) {
+		case 20:
+			return []byte{0x00}
+		case 32:
+			return []byte{0x0A}
+		}
+		return nil
+	}
+	short, _ := hex.DecodeString("6231f1005e86c03d5fbd41776985d094ccb682d3")
+	long, _ := hex.DecodeString("1863143c14c5166804bd19203356da136c985678cd4d27a1b8c6329604903262")
+	vectors := []struct {
+		payload []byte
+		fixed   AddressType
+	}{
+		{short, BTC_mainnetAddressP2PKH},
+		{long, AddressType{EncodeType: "base58", Alphabet: BTCAlphabet, ChecksumType: "doubleSHA256", HashLen: 32, Prefix: []byte{0x0A}}},
+	}
+	for _, v := range vectors {
+		address := AddressEncode(v.payload, bySize)
+		if want := AddressEncode(v.payload, v.fixed); address != want {
+			t.Errorf("encode %x: got %s, want %s", v.payload, address, want)
+		}
+		prefix, ret, err := AddressDecodePrefix(address, bySize)
+		if err != nil || hex.EncodeToString(prefix) != hex.EncodeToString(v.fixed.Prefix) || hex.EncodeToString(ret) != hex.EncodeToString(v.payload) {
+			t.Errorf("decode %s: prefix %x, payload %x, %v", address, prefix, ret, err)
+		}
+	}
+
+	// a 32-byte payload under the version of 20-byte ones
+	mismatched := AddressEncode(long, AddressType{EncodeType: "base58", Alphabet: BTCAlphabet, ChecksumType: "doubleSHA256", HashLen: 32, Prefix: []byte{0x00}})
+	if _, err := AddressDecode(mismatched, bySize); err != ErrorInvalidAddress {
+		t.Errorf("mismatched version decoded: %v", err)
+	}
+	address := AddressEncode(short, bySize)
+	if _, err := AddressDecode(address[:len(address)-1]+"2", bySize); err != ErrorInvalidAddress {
+		t.Errorf("bad checksum decoded: %v", err)
+	}
+	// other lengths are hashed to 20 bytes as with a fixed prefix, even where PrefixFunc takes them
+	if ret, want := AddressEncode(long[:25], bySize), AddressEncode(long[:25], BTC_mainnetAddressP2PKH); ret != want {
+		t.Errorf("hashed payload: got %s, want %s", ret, want)
+	}
+	anySize := bySize
+	anySize.PrefixFunc = func([]byte) []byte { return []byte{0x00} }
+	if ret, want := AddressEncode(long[:25], anySize), AddressEncode(long[:25], BTC_mainnetAddressP2PKH); ret != want {
+		t.Errorf("payload PrefixFunc takes: got %s, want %s", ret, want)
+	}
+	// a payload PrefixFunc takes is still held to the lengths of the type
+	odd := AddressEncode(long[:25], AddressType{EncodeType: "base58", Alphabet: BTCAlphabet, ChecksumType: "doubleSHA256", HashLen: 25, Prefix: []byte{0x00}})
+	if _, err := AddressDecode(odd, anySize); err != ErrorInvalidHashLength {
+		t.Errorf("odd length decoded: %v", err)
+	}
+}
+
+func Test_AddressType_json(t *testing.T) {
+	// the func fields are left out, so every preset marshals, including those setting them
+	withFuncs := BTC_mainnetAddressP2PKH.Clone()
+	withFuncs.PrefixLen = func([]byte) int { return 1 }
+	withFuncs.PrefixFunc = func([]byte) []byte { return []byte{0x00} }
+	for _, at := range []AddressType{BTC_mainnetAddressP2PKH, BTC_mainnetAddressBech32V0, ETH_mainnetPublicAddress, withFuncs} {
+		data, err := json.Marshal(at)
+		if err != nil {
+			t.Errorf("%+v: %v", at, err)
+			continue
+		}
+		var ret AddressType
+		if err := json.Unmarshal(data, &ret); err != nil || ret.EncodeType != at.EncodeType || !bytes.Equal(ret.Prefix, at.Prefix) || ret.PrefixLen != nil {
+			t.Errorf("%s: unmarshaled as %+v, %v", data, ret, err)
+		}
+	}
+}
+
+func Test_LTC_MWEB_address(t *testing.T) {
+	// scan public key G and spend public key 2G
+	keys, _ := hex.DecodeString("0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798" +
+		"02c6047f9441ed7d6d3045406e95c07cd85c778e4b8cef3ca7abac09b95c709ee5")
+	vectors := []struct {
+		addresstype AddressType
+		address     string
+	}{
+		{LTC_mainnetAddressMWEB, "ltcmweb1qqfumuen7l8wthtz45p3ftn58pvrs9xlumvkuu2xet8egzkcklqtesqkxq3legs0d04knq32qd62uqlxct3mcujuvau7202avpxu4cuy7u5l7dx6j"},
+		{LTC_testnetAddressMWEB, "tmweb1qqfumuen7l8wthtz45p3ftn58pvrs9xlumvkuu2xet8egzkcklqtesqkxq3legs0d04knq32qd62uqlxct3mcujuvau7202avpxu4cuy7u57hv3x9"},
+	}
+	for _, v := range vectors {
+		if address := AddressEncode(keys, v.addresstype); address != v.address {
+			t.Errorf("encode got %s, want %s", address, v.address)
+		}
+		ret, err := AddressDecode(v.address, v.addresstype)
+		if err != nil || hex.EncodeToString(ret) != hex.EncodeToString(keys) {
+			t.Errorf("decode %s: %x, %v", v.address, ret, err)
+		}
+	}
+
+	if _, err := AddressDecode(vectors[1].address, LTC_mainnetAddressMWEB); err == nil {
+		t.Error("testnet mweb address decoded on mainnet")
+	}
+	if _, err := AddressDecode(AddressEncode(keys[:33], LTC_mainnetAddressMWEB), LTC_mainnetAddressMWEB); err != ErrorInvalidHashLength {
+		t.Errorf("a single key should be rejected: %v", err)
+	}
+}
+
+func Test_EncodeScriptHash(t *testing.T) {
+	// 2-of-3 multisig redeem script over the public keys of 1G, 2G and 3G
+	script, _ := hex.DecodeString("5221" + "0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798" +
+		"21" + "02c6047f9441ed7d6d3045406e95c07cd85c778e4b8cef3ca7abac09b95c709ee5" +
+		"21" + "02f9308a019258c31049344f85f89d5229b531c845836f99b08601f113bce036f9" + "53ae")
+
+	if address := EncodeScriptHash(script, BTC_mainnetAddressP2SH); address != "33hG2q39jRi2NqicRJB4ggY1J8EJm97Szz" {
+		t.Error("mainnet P2SH wrong result:", address)
+	}
+	if address := EncodeScriptHash(script, BTC_testnetAddressP2SH); address != "2MuFU6ZyBLtDNadMA6RnwJdXGWUSUaoKLeS" {
+		t.Error("testnet P2SH wrong result:", address)
+	}
+	hash, err := AddressDecode("33hG2q39jRi2NqicRJB4ggY1J8EJm97Szz", BTC_mainnetAddressP2SH)
+	if err != nil || hex.EncodeToString(hash) != "15fc0754e73eb85d1cbce08786fadb7320ecb8dc" {
+		t.Error("decode of the script hash failed:", hex.EncodeToString(hash), err)
+	}
+
+	// a script of HashLen bytes is hashed too, where AddressEncode would take it as the hash
+	script20 := make([]byte, 20)
+	for i := range script20 {
+		script20[i] = byte(i)
+	}
+	if address := EncodeScriptHash(script20, BTC_mainnetAddressP2SH); address != "3JAxm5BpUnhU57LMAkMkUYiC3o5ygLAG7k" {
+		t.Error("20 bytes script wrong result:", address)
+	}
+
+	// no hash type configured
+	if address := EncodeScriptHash(script, BTC_mainnetAddressTaproot); address != "" {
+		t.Error("script hashed without a hash type:", address)
+	}
+}
+
+func Test_AddressEncode_keepsInput(t *testing.T) {
+	hash, _ := hex.DecodeString("62e907b15cbf27d5425399ebf6f0fb50ebb88f18")
+	pubkey, _ := hex.DecodeString("0202a406624211f2abbdc68da3df929f938c3399dd79fac1b51b0e4ad1d26a47aa")
+	aeKey := make([]byte, 32)
+
+	tests := []struct {
+		data        []byte
+		addresstype AddressType
+	}{
+		{hash, BTC_mainnetAddressP2PKH},
+		{pubkey, BTC_mainnetAddressP2PKH},
+		{pubkey, EOS_mainnetPublic},
+		{aeKey, AE_mainnetAddress},
+		{make([]byte, 64), XMR_mainnetPublicAddress},
+	}
+	for _, test := range tests {
+		buf := make([]byte, len(test.data), len(test.data)+16)
+		copy(buf, test.data)
+		spare := buf[:cap(buf)]
+		for i := len(buf); i < len(spare); i++ {
+			spare[i] = 0xee
+		}
+		orig := append([]byte{}, spare...)
+
+		prefix := make([]byte, len(test.addresstype.Prefix), len(test.addresstype.Prefix)+16)
+		copy(prefix, test.addresstype.Prefix)
+		test.addresstype.Prefix = prefix
+		origPrefix := append([]byte{}, prefix[:cap(prefix)]...)
+
+		want := AddressEncode(test.data, test.addresstype)
+		if got := AddressEncode(buf, test.addresstype); got != want || got == "" {
+			t.Errorf("%s: got %s, want %s", test.addresstype.EncodeType, got, want)
+		}
+		if !bytes.Equal(spare, orig) {
+			t.Errorf("%s: input changed to %x", test.addresstype.EncodeType, spare)
+		}
+		if !bytes.Equal(prefix[:cap(prefix)], origPrefix) {
+			t.Errorf("%s: prefix changed to %x", test.addresstype.EncodeType, prefix[:cap(prefix)])
+		}
+	}
+}
+
+func Test_AddressEncode_concurrent(t *testing.T) {
+	// a shared prefix with spare capacity, which a plain append would write the hash into
+	prefix := make([]byte, 1, 64)
+	addresstype := BTC_mainnetAddressP2PKH
+	addresstype.Prefix = prefix
+
+	hashes := make([][]byte, 16)
+	expect := make([]string, len(hashes))
+	for i := range hashes {
+		hashes[i] = bytes.Repeat([]byte{byte(i)}, 20)
+		expect[i] = AddressEncode(hashes[i], BTC_mainnetAddressP2PKH)
+	}
+
+	var wg sync.WaitGroup
+	for i := range hashes {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for n := 0; n < 200; n++ {
+				if ret := AddressEncode(hashes[i], addresstype); ret != expect[i] {
+					t.Errorf("hash %d: got %s, want %s", i, ret, expect[i])
+					return
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if !bytes.Equal(prefix[:cap(prefix)], make([]byte, cap(prefix))) {
+		t.Errorf("shared prefix changed to %x", prefix[:cap(prefix)])
+	}
+}
+
+func Test_sha256LastTwenty(t *testing.T) {
+	// sha256("") = e3b0c44298fc1c149afbf4c8 996fb92427ae41e4649b934ca495991b7852b855
+	if ret := hex.EncodeToString(calcHash([]byte{}, "sha256_last_twenty")); ret != "996fb92427ae41e4649b934ca495991b7852b855" {
+		t.Error("empty input wrong result:", ret)
+	}
+	for _, data := range [][]byte{[]byte("abc"), make([]byte, 33), bytes.Repeat([]byte{0xff}, 100)} {
+		digest := sha256.Sum256(data)
+		if ret := calcHash(data, "sha256_last_twenty"); !bytes.Equal(ret, digest[12:]) {
+			t.Errorf("%x: got %x, want %x", data, ret, digest[12:])
+		}
+	}
+}
+
+func Test_XTZ_tz4_address(t *testing.T) {
+	address := "tz4HVR6aty9KwsQFHh81C1G7gBdhxT8kuytm"
+	hash, _ := hex.DecodeString("5d1497f39b87599983fe8f29599b679564be822d")
+
+	if ret := AddressEncode(hash, XTZ_mainnetAddress_tz4); ret != address {
+		t.Error("encode wrong result:", ret)
+	}
+	ret, err := AddressDecode(address, XTZ_mainnetAddress_tz4)
+	if err != nil || hex.EncodeToString(ret) != hex.EncodeToString(hash) {
+		t.Error("decode wrong result:", hex.EncodeToString(ret), err)
+	}
+	if ok, err := AddressCheck(address, "XTZ"); !ok || err != nil {
+		t.Error("tz4 address check failed:", err)
+	}
+
+	// the prefix bytes tell the kinds apart
+	for _, at := range []AddressType{XTZ_mainnetAddress_tz1, XTZ_mainnetAddress_tz2, XTZ_mainnetAddress_tz3} {
+		if _, err := AddressDecode(address, at); err == nil {
+			t.Errorf("tz4 address decoded with prefix %x", at.Prefix)
+		}
+	}
+	if _, err := AddressDecode("tz1iycVGryQop8nryZWcXfvtiK5KvxC5coUS", XTZ_mainnetAddress_tz4); err == nil {
+		t.Error("tz1 address decoded as tz4")
+	}
+}
+
+func Test_FormatGrouped(t *testing.T) {
+	tests := map[string]string{
+		"bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4":             "bc1 qw50 8d6q ejxt dg4y 5r3z arva ry0c 5xw7 kv8f 3t4",
+		"BC1QW508D6QEJXTDG4Y5R3ZARVARY0C5XW7KV8F3T4":             "BC1 QW50 8D6Q EJXT DG4Y 5R3Z ARVA RY0C 5XW7 KV8F 3T4",
+		"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed":             "0x 5aAe b605 3F3E 94C9 b9A0 9f33 6694 35E7 Ef1B eAed",
+		"1BpEi6DfDAUFd7GtittLSdBeYJvcoaVggu":                     "1BpE i6Df DAUF d7Gt ittL SdBe YJvc oaVg gu",
+		"bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a": "bitcoincash: qpm2 qszn hks2 3z76 29mm s6s4 cwef 74vc wvy2 2gdx 6a",
+	}
+	for address, expect := range tests {
+		if ret := FormatGrouped(address, 4, " "); ret != expect {
+			t.Errorf("got %q, want %q", ret, expect)
+		}
+	}
+	if ret := FormatGrouped("1BpEi6DfDAUFd7GtittLSdBeYJvcoaVggu", 0, " "); ret != "1BpEi6DfDAUFd7GtittLSdBeYJvcoaVggu" {
+		t.Error("group size 0 changed the address:", ret)
+	}
+}
+
+func Test_DOGE_LTC_testnet_address(t *testing.T) {
+	hash, _ := hex.DecodeString("751e76e8199196d454941c45d1b3a323f1433bd6")
+	tests := []struct {
+		addresstype AddressType
+		address     string
+	}{
+		{DOGE_mainnetAddressP2PKH, "DFpN6QqFfUm3gKNaxN6tNcab1FArL9cZLE"},
+		{DOGE_mainnetAddressP2SH, "A37YDYSwz3438rFtm1SLVcQHyD7JeueC9H"},
+		{DOGE_testnetAddressP2PKH, "nesRpRaAbTDmZHwmzBkLd2AtF7Z9L9z5S2"},
+		{DOGE_testnetAddressP2SH, "2N3vVYSK5XRgVSGWy21PnsRmBUywSQNdCsf"},
+		{LTC_testnetAddressP2PKH, "mrCDrCybB6J1vRfbwM5hemdJz73FwDBC8r"},
+		{LTC_testnetAddressP2SH2, "QXHFfTBKYXjaaTH1e7Rox8CcdNPGHVhM59"},
+		{LTC_testnetAddressBech32V0, "tltc1qw508d6qejxtdg4y5r3zarvary0c5xw7klfsuq0"},
+	}
+	for _, test := range tests {
+		if ret := AddressEncode(hash, test.addresstype); ret != test.address {
+			t.Errorf("encode got %s, want %s", ret, test.address)
+		}
+		ret, err := AddressDecode(test.address, test.addresstype)
+		if err != nil || hex.EncodeToString(ret) != hex.EncodeToString(hash) {
+			t.Errorf("decode %s: %x, %v", test.address, ret, err)
+		}
+	}
+
+	// the mainnet forms are rejected by the testnet presets, and the other way around
+	mismatches := []struct {
+		addresstype AddressType
+		address     string
+	}{
+		{DOGE_testnetAddressP2PKH, "DFpN6QqFfUm3gKNaxN6tNcab1FArL9cZLE"},
+		{DOGE_mainnetAddressP2PKH, "nesRpRaAbTDmZHwmzBkLd2AtF7Z9L9z5S2"},
+		{LTC_testnetAddressBech32V0, "ltc1qw508d6qejxtdg4y5r3zarvary0c5xw7kgmn4n9"},
+		{LTC_mainnetAddressBech32V0, "tltc1qw508d6qejxtdg4y5r3zarvary0c5xw7klfsuq0"},
+		{BTC_mainnetAddressBech32V0, "tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx"},
+	}
+	for _, test := range mismatches {
+		if _, err := AddressDecode(test.address, test.addresstype); err == nil {
+			t.Errorf("%s decoded with prefix %x and hrp %s", test.address, test.addresstype.Prefix, test.addresstype.ChecksumType)
+		}
+	}
+}
+
+func Test_GenerateAddress(t *testing.T) {
+	// the public key of the secret key 1
+	compressed, _ := hex.DecodeString("0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798")
+	uncompressed, _ := hex.DecodeString("79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798" +
+		"483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b8")
+
+	tests := []struct {
+		pubkey      []byte
+		addresstype AddressType
+		address     string
+	}{
+		{compressed, BTC_mainnetAddressP2PKH, "1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH"},
+		{compressed, BTC_mainnetAddressBech32V0, "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4"},
+		{compressed, ZIL_mainnetAddress, "zil198jk9ae53ry29wuah3tspvmp649ekp250ajt0a"},
+		{uncompressed, ETH_mainnetPublicAddress, "7e5f4552091a69125d5dfcb7b8c2659029395bdf"},
+		{uncompressed, TRON_mainnetAddress, "TMVQGm1qAQYVdetCeGRRkTWYYrLXuHK2HC"},
+		{uncompressed[:32], BTC_mainnetAddressTaproot, "bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj0"},
+	}
+	for _, test := range tests {
+		ret, err := GenerateAddress(test.pubkey, test.addresstype)
+		if err != nil || ret != test.address {
+			t.Errorf("%s: got %s, %v, want %s", test.addresstype.EncodeType, ret, err, test.address)
+		}
+	}
+
+	// keys encoded as they are must have the expected length
+	if ret, err := GenerateAddress(compressed, BTC_mainnetAddressTaproot); err != ErrorInvalidHashLength {
+		t.Error("33 bytes taproot key encoded:", ret, err)
+	}
+}
+
+func Test_EncodeHash(t *testing.T) {
+	compressed, _ := hex.DecodeString("0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798")
+	hash, _ := hex.DecodeString("751e76e8199196d454941c45d1b3a323f1433bd6")
+
+	// 20 bytes are taken as the hash by AddressEncode and EncodeHash, but hashed again by GenerateAddress
+	if ret := AddressEncode(hash, BTC_mainnetAddressP2PKH); ret != "1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH" {
+		t.Error("AddressEncode of the hash:", ret)
+	}
+	if ret, err := EncodeHash(hash, BTC_mainnetAddressP2PKH); err != nil || ret != "1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH" {
+		t.Error("EncodeHash of the hash:", ret, err)
+	}
+	if ret, _ := GenerateAddress(hash, BTC_mainnetAddressP2PKH); ret != AddressEncode(calcHash(hash, "h160"), BTC_mainnetAddressP2PKH) {
+		t.Error("GenerateAddress did not hash the 20 bytes:", ret)
+	}
+
+	// the 33-byte key is hashed by AddressEncode and GenerateAddress, EncodeHash refuses it
+	if ret := AddressEncode(compressed, BTC_mainnetAddressP2PKH); ret != "1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH" {
+		t.Error("AddressEncode of the key:", ret)
+	}
+	if ret, err := EncodeHash(compressed, BTC_mainnetAddressP2PKH); err != ErrorInvalidHashLength {
+		t.Error("EncodeHash of the key:", ret, err)
+	}
+
+	// eip55 keeps 20 of the 32 bytes of its hash
+	if ret, err := EncodeHash(hash, ETH_mainnetPublicAddress); err != nil || ret != hex.EncodeToString(hash) {
+		t.Error("EncodeHash of an eip55 address:", ret, err)
+	}
+}
+
+func Test_emptyPrefixSuffix(t *testing.T) {
+	// as built from JSON, where an empty string gives an empty but non-nil slice
+	var fromJSON AddressType
+	if err := json.Unmarshal([]byte(`{"EncodeType":"base58","Alphabet":"`+BTCAlphabet+`","ChecksumType":"doubleSHA256","HashType":"h160","HashLen":20,"Prefix":"AA==","Suffix":""}`), &fromJSON); err != nil {
+		t.Fatal(err)
+	}
+	if fromJSON.Suffix == nil || len(fromJSON.Suffix) != 0 {
+		t.Fatalf("suffix unmarshaled as %#v", fromJSON.Suffix)
+	}
+	if ret := AddressEncode(make([]byte, 20), fromJSON); ret != AddressEncode(make([]byte, 20), BTC_mainnetAddressP2PKH) {
+		t.Error("JSON preset encode wrong result:", ret)
+	}
+
+	hash, _ := hex.DecodeString("751e76e8199196d454941c45d1b3a323f1433bd6")
+	for _, at := range []AddressType{BTC_mainnetAddressP2PKH, ATOM_mainnetAddress, XRP_Address, ETH_mainnetPublicAddress} {
+		empty := at.Clone()
+		if empty.Prefix == nil {
+			empty.Prefix = []byte{}
+		}
+		empty.Suffix = []byte{}
+
+		address := AddressEncode(hash, at)
+		if ret := AddressEncode(hash, empty); ret != address {
+			t.Errorf("%s: encode with empty slices got %s, want %s", at.EncodeType, ret, address)
+		}
+		want, wantErr := AddressDecode(address, at)
+		ret, err := AddressDecode(address, empty)
+		if !bytes.Equal(ret, want) || err != wantErr {
+			t.Errorf("%s: decode with empty slices got %x %v, want %x %v", at.EncodeType, ret, err, want, wantErr)
+		}
+	}
+
+	// data shorter than prefix and suffix is an error, not a panic
+	if _, err := recoverData([]byte{0x01}, []byte{0x01}, []byte{0x02}); err != ErrorInvalidAddress {
+		t.Error("short data recovered:", err)
+	}
+	if ret, err := recoverData([]byte{0x01, 0x02}, []byte{}, nil); err != nil || !bytes.Equal(ret, []byte{0x01, 0x02}) {
+		t.Error("empty prefix recover wrong result:", ret, err)
+	}
+}
+
+func Test_LUNA_address(t *testing.T) {
+	hash, _ := hex.DecodeString("751e76e8199196d454941c45d1b3a323f1433bd6")
+	tests := []struct {
+		addresstype AddressType
+		address     string
+	}{
+		{LUNA_mainnetAddress, "terra1w508d6qejxtdg4y5r3zarvary0c5xw7kued6dc"},
+		{LUNA_mainnetValoperAddress, "terravaloper1w508d6qejxtdg4y5r3zarvary0c5xw7kukp8at"},
+	}
+	for _, test := range tests {
+		if ret := AddressEncode(hash, test.addresstype); ret != test.address {
+			t.Errorf("encode got %s, want %s", ret, test.address)
+		}
+		ret, err := AddressDecode(test.address, test.addresstype)
+		if err != nil || hex.EncodeToString(ret) != hex.EncodeToString(hash) {
+			t.Errorf("decode %s: %x, %v", test.address, ret, err)
+		}
+	}
+
+	// the same hash under the other cosmos HRPs is rejected
+	for _, address := range []string{"cosmos1w508d6qejxtdg4y5r3zarvary0c5xw7k6ah60c", "terravaloper1w508d6qejxtdg4y5r3zarvary0c5xw7kukp8at"} {
+		if _, err := AddressDecode(address, LUNA_mainnetAddress); err == nil {
+			t.Error("decoded as a terra account:", address)
+		}
+	}
+	if _, err := AddressDecode("terra1w508d6qejxtdg4y5r3zarvary0c5xw7kued6dc", ATOM_mainnetAddress); err == nil {
+		t.Error("terra address decoded as cosmos")
+	}
+	// terra accounts are 20-byte hashes only
+	program := make([]byte, 32)
+	for _, addresstype := range []AddressType{LUNA_mainnetAddress, LUNA_mainnetValoperAddress} {
+		address := AddressEncode(program, addresstype)
+		if _, err := AddressDecode(address, addresstype); err != ErrorInvalidHashLength {
+			t.Errorf("%s: got %v, want ErrorInvalidHashLength", address, err)
+		}
+	}
+}
+
+func Test_base58_short_checksum(t *testing.T) {
+	// a base58 scheme with the 2-byte crc16 checksum
+	twoBytes := AddressType{EncodeType: "base58", Alphabet: BTCAlphabet, ChecksumType: "crc16", HashLen: 20, Prefix: []byte{0x30}}
+	hash, _ := hex.DecodeString("751e76e8199196d454941c45d1b3a323f1433bd6")
+
+	address := AddressEncode(hash, twoBytes)
+	decoded, _ := Base58Decode(address, NewBase58Alphabet(BTCAlphabet))
+	if len(decoded) != 1+20+2 {
+		t.Fatalf("%s carries %d bytes, want 23", address, len(decoded))
+	}
+	crc := crc16(decoded[:21])
+	if decoded[21] != byte(crc) || decoded[22] != byte(crc>>8) {
+		t.Errorf("checksum %x, want %04x", decoded[21:], crc)
+	}
+	ret, err := AddressDecode(address, twoBytes)
+	if err != nil || hex.EncodeToString(ret) != hex.EncodeToString(hash) {
+		t.Error("decode wrong result:", hex.EncodeToString(ret), err)
+	}
+
+	// the same scheme with the usual 4 bytes does not take it
+	if _, err := AddressDecode(address, AddressType{EncodeType: "base58", Alphabet: BTCAlphabet, ChecksumType: "doubleSHA256", HashLen: 20, Prefix: []byte{0x30}}); err == nil {
+		t.Error("2-byte checksum address decoded with a 4-byte checksum")
+	}
+
+	// the prefixed string encodings strip the checksum of their type as well
+	aeTwoBytes := AE_mainnetAddress.WithChecksumType("crc16")
+	key := bytes.Repeat([]byte{0x11}, 32)
+	if ret, err := AddressDecode(AddressEncode(key, aeTwoBytes), aeTwoBytes); err != nil || !bytes.Equal(ret, key) {
+		t.Errorf("aeternity with crc16 got %x, %v", ret, err)
+	}
+}
+
+func Test_AddressToHex(t *testing.T) {
+	tests := []struct {
+		address     string
+		addresstype AddressType
+		expect      string
+	}{
+		{"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", BTC_mainnetAddressP2PKH, "77bff20c60e522dfaa3350c39b030a5d004e839a"},
+		{"bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4", BTC_mainnetAddressBech32V0, "751e76e8199196d454941c45d1b3a323f1433bd6"},
+		{"BC1QW508D6QEJXTDG4Y5R3ZARVARY0C5XW7KV8F3T4", BTC_mainnetAddressBech32V0, "751e76e8199196d454941c45d1b3a323f1433bd6"},
+	}
+	for _, test := range tests {
+		ret, err := AddressToHex(test.address, test.addresstype)
+		if err != nil || ret != test.expect {
+			t.Errorf("%s: got %s, %v, want %s", test.address, ret, err, test.expect)
+		}
+	}
+
+	if ret, err := AddressToHex("1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN3", BTC_mainnetAddressP2PKH); err == nil || ret != "" {
+		t.Errorf("bad checksum gave %s, %v", ret, err)
+	}
+	if _, err := AddressToHex("bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4", BTC_mainnetAddressP2PKH); err == nil {
+		t.Error("bech32 address decoded as base58")
+	}
+}
+
+func Test_HexToAddress(t *testing.T) {
+	tests := []struct {
+		address     string
+		addresstype AddressType
+	}{
+		{"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", BTC_mainnetAddressP2PKH},
+		{"3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy", BTC_mainnetAddressP2SH},
+		{"bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4", BTC_mainnetAddressBech32V0},
+		{"bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj0", BTC_mainnetAddressTaproot},
+		{"ltc1qg42tkwuuxefutzxezdkdel39gfstuap288mfea", LTC_mainnetAddressBech32V0},
+		{"5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", ETH_mainnetPublicAddress},
+	}
+	for _, test := range tests {
+		hash, err := AddressToHex(test.address, test.addresstype)
+		if err != nil {
+			t.Errorf("%s: %v", test.address, err)
+			continue
+		}
+		if ret, err := HexToAddress(hash, test.addresstype); err != nil || ret != test.address {
+			t.Errorf("%s: round trip gave %s, %v", test.address, ret, err)
+		}
+	}
+
+	// a 32-byte cosmos account is not hashed down to its HashLen
+	account := "1863143c14c5166804bd19203356da136c985678cd4d27a1b8c6329604903262"
+	if address, err := HexToAddress(account, ATOM_mainnetAddress); err != nil {
+		t.Error("32 bytes cosmos account:", err)
+	} else if ret, err := AddressToHex(address, ATOM_mainnetAddress); err != nil || ret != account {
+		t.Errorf("%s: round trip gave %s, %v", address, ret, err)
+	}
+	if _, err := HexToAddress("751e76e8199196d454941c45d1b3a323f1433b", BTC_mainnetAddressP2PKH); err != ErrorInvalidHashLength {
+		t.Error("19 bytes hash encoded:", err)
+	}
+	if _, err := HexToAddress("751e76e8199196d454941c45d1b3a323f1433bzz", BTC_mainnetAddressP2PKH); err != ErrorInvalidAddress {
+		t.Error("bad hex encoded:", err)
+	}
+}
+
+func Test_nonASCII_address(t *testing.T) {
+	tests := []struct {
+		address     string
+		addresstype AddressType
+		offset      string
+	}{
+		// the cyrillic а (U+0430) in place of the latin a
+		{"1BvBMSEYstWetqTFn5Au4m4GFg7xJаNVN2", BTC_mainnetAddressP2PKH, "U+0430 at offset 29"},
+		// the cyrillic с (U+0441) in place of the latin c
+		{"bitcoinсash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a", BCH_mainnetAddressCash, "U+0441 at offset 7"},
+		{"bc1qw508d6qejxtdg4y5r3zarvary0с5xw7kv8f3t4", BTC_mainnetAddressBech32V0, "U+0441 at offset 30"},
+	}
+	for _, test := range tests {
+		_, err := AddressDecode(test.address, test.addresstype)
+		if !errors.Is(err, ErrorNonASCIICharacter) || !strings.HasSuffix(err.Error(), test.offset) {
+			t.Errorf("%s: got %v", test.address, err)
+		}
+	}
+	if _, err := AddressDecode("1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", BTC_mainnetAddressP2PKH); err != nil {
+		t.Error("ascii address rejected:", err)
+	}
+}
+
+func Test_AddressDecode_unknownEncodeType(t *testing.T) {
+	// a zero HashLen used to let the empty data of an unknown encode type through
+	for _, hashLen := range []int{0, 20} {
+		addresstype := AddressType{EncodeType: "unknown", HashLen: hashLen}
+		if ret, err := AddressDecode("1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", addresstype); err != ErrorInvalidAddress {
+			t.Errorf("HashLen %d: got %x, %v", hashLen, ret, err)
+		}
+	}
+}
+
+func Test_checkLength(t *testing.T) {
+	tests := []struct {
+		got         int
+		addresstype AddressType
+		ok          bool
+	}{
+		{20, BTC_mainnetAddressP2PKH, true},
+		{21, BTC_mainnetAddressP2PKH, false},
+		{20, ETH_mainnetPublicAddress, true},
+		{32, ETH_mainnetPublicAddress, false}, // HashLen is the keccak256 the address is cut from
+		{32, BTC_mainnetAddressBech32V0, true},
+		{32, BTC_mainnetAddressP2WSH, true},
+		{20, BTC_mainnetAddressP2WSH, false},
+		{20, ATOM_mainnetAddress, true},
+		{32, ATOM_mainnetAddress, true},
+		{21, ATOM_mainnetAddress, false},
+		{66, LTC_mainnetAddressMWEB, true},
+		{32, LTC_mainnetAddressMWEB, false},
+	}
+	for _, test := range tests {
+		if err := checkLength(test.got, test.addresstype); (err == nil) != test.ok {
+			t.Errorf("%d bytes for %+v: %v", test.got, test.addresstype, err)
+		}
+	}
+	// AddressCheck takes the P2WSH programs of the bech32 v0 presets
+	for _, address := range []string{"bc1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3qccfmv3", "tb1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3q0sl5k7"} {
+		if ok, err := AddressCheck(address, "BTC"); !ok || err != nil {
+			t.Errorf("%s: %v", address, err)
+		}
+	}
+
+	// 19-byte payloads in otherwise valid addresses of each encode type
+	short := make([]byte, 19)
+	withChecksum := func(data []byte, chkType string) []byte {
+		return catData(data, calcChecksum(data, chkType))
+	}
+	alphabet := NewBase58Alphabet(BTCAlphabet)
+	addresses := []struct {
+		address     string
+		addresstype AddressType
+	}{
+		{Base58Encode(withChecksum(catData([]byte{0x00}, short), "doubleSHA256"), alphabet), BTC_mainnetAddressP2PKH},
+		{bech32.Encode("cosmos", ATOMBech32Alphabet, short, nil), ATOM_mainnetAddress},
+		{"0x" + hex.EncodeToString(short), ETH_mainnetPublicAddress},
+		{"hx" + hex.EncodeToString(short), ICX_walletAddress},
+		{"EOS" + Base58Encode(withChecksum(make([]byte, 32), "ripemd160"), alphabet), EOS_mainnetPublic},
+		{"ak_" + Base58Encode(withChecksum(make([]byte, 31), "doubleSHA256"), alphabet), AE_mainnetAddress},
+	}
+	for _, test := range addresses {
+		if _, err := AddressDecode(test.address, test.addresstype); err != ErrorInvalidHashLength {
+			t.Errorf("%s: got %v", test.address, err)
+		}
+	}
+}
+
+func Test_base58_fixed_length(t *testing.T) {
+	alphabet := NewBase58Alphabet(BTCAlphabet)
+	decoded, _ := Base58Decode("1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", alphabet)
+
+	// a truncated or extended address fails on its length, before the checksum is looked at
+	for _, data := range [][]byte{decoded[:len(decoded)-1], decoded[:5], append(decoded, 0)} {
+		address := Base58Encode(data, alphabet)
+		if _, err := AddressDecode(address, BTC_mainnetAddressP2PKH); err != ErrorInvalidHashLength {
+			t.Errorf("%s: got %v, want ErrorInvalidHashLength", address, err)
+		}
+	}
+	// the right length with a broken checksum
+	broken := append([]byte{}, decoded...)
+	broken[len(broken)-1] ^= 1
+	if _, err := AddressDecode(Base58Encode(broken, alphabet), BTC_mainnetAddressP2PKH); err != ErrorInvalidAddress {
+		t.Error("broken checksum:", err)
+	}
+
+	// any of HashLens is a length the address may have
+	twoSizes := BTC_mainnetAddressP2PKH
+	twoSizes.HashLens = []int{20, 32}
+	hash := make([]byte, 32)
+	withChecksum := func(data []byte) []byte {
+		body := catData([]byte{0x00}, data)
+		return catData(body, calcChecksum(body, "doubleSHA256"))
+	}
+	for _, n := range []int{20, 32} {
+		address := Base58Encode(withChecksum(hash[:n]), alphabet)
+		if ret, err := AddressDecode(address, twoSizes); err != nil || len(ret) != n {
+			t.Errorf("%s: got %x, %v", address, ret, err)
+		}
+	}
+	address := Base58Encode(withChecksum(hash[:25]), alphabet)
+	if _, err := AddressDecode(address, twoSizes); err != ErrorInvalidHashLength {
+		t.Errorf("%s: got %v, want ErrorInvalidHashLength", address, err)
+	}
+}
+
+func Test_AddressEncodeWithVersion(t *testing.T) {
+	hash, _ := hex.DecodeString("751e76e8199196d454941c45d1b3a323f1433bd6")
+	tests := []struct {
+		version     []byte
+		addresstype AddressType
+		address     string
+	}{
+		{[]byte{0x05}, BTC_mainnetAddressP2PKH, "3CNHUhP3uyB9EUtRLsmvFUmvGdjGdkTxJw"},
+		{[]byte{0x32}, LTC_mainnetAddressP2PKH, "MJaRnao1s62a2zAKSkmG582KbLKianqb7v"},
+		{[]byte{0x16}, DOGE_mainnetAddressP2PKH, "A37YDYSwz3438rFtm1SLVcQHyD7JeueC9H"},
+		{[]byte{0x1C, 0xBD}, ZEC_mainnet_t_AddressP2PKH, "t3VEtV2oBtHxjq7wKHJb3PHsqXHvMRgUmVw"},
+	}
+	for _, test := range tests {
+		address, err := AddressEncodeWithVersion(hash, test.version, test.addresstype)
+		if err != nil || address != test.address {
+			t.Errorf("encode with version %x: got %s, %v, want %s", test.version, address, err, test.address)
+		}
+		data, err := AddressDecodeWithVersion(test.address, test.version, test.addresstype)
+		if err != nil || !bytes.Equal(data, hash) {
+			t.Errorf("decode %s with version %x failed: %x, %v", test.address, test.version, data, err)
+		}
+		if _, err := AddressDecode(test.address, test.addresstype); err == nil {
+			t.Errorf("%s decoded without the version override", test.address)
+		}
+		// the override leaves the preset untouched
+		if ret := AddressEncode(hash, test.addresstype); ret == test.address {
+			t.Errorf("preset changed by the override: %s", ret)
+		}
+	}
+
+	if _, err := AddressDecodeWithVersion("3CNHUhP3uyB9EUtRLsmvFUmvGdjGdkTxJw", []byte{0x00}, BTC_mainnetAddressP2PKH); err == nil {
+		t.Error("decoded with the wrong version")
+	}
+	if _, err := AddressEncodeWithVersion(hash[:19], []byte{0x05}, BTC_mainnetAddressP2PKH); err != ErrorInvalidHashLength {
+		t.Error("short hash:", err)
+	}
+}
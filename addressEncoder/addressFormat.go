@@ -0,0 +1,53 @@
+package addressEncoder
+
+import "strings"
+
+// displayPrefixLen returns the length of the part of address that FormatGrouped keeps in one piece:
+// the 0x of hex addresses, the prefix and colon of cashaddr ones, and the human readable part and
+// separator of bech32 ones. A plain base58 address has none.
+func displayPrefixLen(address string) int {
+	if strings.HasPrefix(address, "0x") || strings.HasPrefix(address, "0X") {
+		return 2
+	}
+	if pos := strings.IndexByte(address, ':'); pos > 0 {
+		return pos + 1
+	}
+	// bech32 strings are single case and their data part, checksum included, only uses the bech32 charset
+	lower := strings.ToLower(address)
+	if lower != address && strings.ToUpper(address) != address {
+		return 0
+	}
+	pos := strings.LastIndexByte(lower, '1')
+	if pos < 1 || len(lower)-pos-1 < 6 {
+		return 0
+	}
+	for i := pos + 1; i < len(lower); i++ {
+		if strings.IndexByte(BTCBech32Alphabet, lower[i]) == -1 {
+			return 0
+		}
+	}
+	return pos + 1
+}
+
+// FormatGrouped splits address into groups of groupSize characters joined by sep, for display only.
+// The 0x of hex addresses and the human readable part of bech32 and cashaddr addresses, together
+// with their separator, are kept in front as they are and only the rest is grouped.
+// address is returned unchanged if groupSize is not positive.
+func FormatGrouped(address string, groupSize int, sep string) string {
+	if groupSize <= 0 {
+		return address
+	}
+	n := displayPrefixLen(address)
+	groups := make([]string, 0, (len(address)-n)/groupSize+2)
+	if n > 0 {
+		groups = append(groups, address[:n])
+	}
+	for i := n; i < len(address); i += groupSize {
+		end := i + groupSize
+		if end > len(address) {
+			end = len(address)
+		}
+		groups = append(groups, address[i:end])
+	}
+	return strings.Join(groups, sep)
+}
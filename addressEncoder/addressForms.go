@@ -0,0 +1,63 @@
+package addressEncoder
+
+import (
+	"errors"
+)
+
+// BitcoinP2SHP2WPKH is the P2WPKH program nested in a P2SH output, its address is a plain p2sh one
+const BitcoinP2SHP2WPKH = "p2sh-p2wpkh"
+
+var (
+	ErrorUnknownCoin = errors.New("Unknown coin!")
+)
+
+// coinAddressForms are the presets of the standard address forms of a coin, a zero Taproot has no p2tr form
+type coinAddressForms struct {
+	P2PKH   AddressType
+	P2SH    AddressType
+	P2WPKH  AddressType
+	Taproot AddressType
+}
+
+var addressForms = map[string]coinAddressForms{
+	"BTC": {BTC_mainnetAddressP2PKH, BTC_mainnetAddressP2SH, BTC_mainnetAddressBech32V0, BTC_mainnetAddressTaproot},
+	"LTC": {LTC_mainnetAddressP2PKH, LTC_mainnetAddressP2SH2, LTC_mainnetAddressBech32V0, AddressType{}},
+}
+
+// AllAddressForms returns every standard address of pubkey on the mainnet of coin, keyed by the kinds
+// BitcoinP2PKH, BitcoinP2SHP2WPKH, BitcoinP2WPKH and BitcoinP2TR. The p2tr one is the BIP-86 key path
+// output of pubkey. The witness forms need a compressed key, an uncompressed one only has the P2PKH form.
+// The supported coins are BTC and LTC, ErrorUnknownCoin is returned for the others.
+func AllAddressForms(pubkey []byte, coin string) (map[string]string, error) {
+	forms, ok := addressForms[coin]
+	if !ok {
+		return nil, ErrorUnknownCoin
+	}
+	if !isPublicKey(pubkey) {
+		return nil, ErrorInvalidPubKey
+	}
+	p2pkh, err := GenerateAddress(pubkey, forms.P2PKH)
+	if err != nil {
+		return nil, err
+	}
+	ret := map[string]string{BitcoinP2PKH: p2pkh}
+	if len(pubkey) != 33 {
+		return ret, nil
+	}
+
+	if ret[BitcoinP2WPKH], err = GenerateAddress(pubkey, forms.P2WPKH); err != nil {
+		return nil, err
+	}
+	redeemScript := catData([]byte{0x00, 0x14}, calcHash(pubkey, "h160"))
+	if ret[BitcoinP2SHP2WPKH] = EncodeScriptHash(redeemScript, forms.P2SH); ret[BitcoinP2SHP2WPKH] == "" {
+		return nil, ErrorInvalidAddress
+	}
+	if forms.Taproot.EncodeType != "" {
+		outputKey, err := TweakTaprootKey(pubkey[1:], nil)
+		if err != nil {
+			return nil, err
+		}
+		ret[BitcoinP2TR] = AddressEncode(outputKey, forms.Taproot)
+	}
+	return ret, nil
+}
@@ -0,0 +1,48 @@
+package addressEncoder
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func Test_AllAddressForms(t *testing.T) {
+	// the public key of private key 1
+	pubkey, _ := hex.DecodeString("0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798")
+	want := map[string]string{
+		BitcoinP2PKH:      "1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH",
+		BitcoinP2SHP2WPKH: "3JvL6Ymt8MVWiCNHC7oWU6nLeHNJKLZGLN",
+		BitcoinP2WPKH:     "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4",
+		BitcoinP2TR:       "bc1pmfr3p9j00pfxjh0zmgp99y8zftmd3s5pmedqhyptwy6lm87hf5sspknck9",
+	}
+	forms, err := AllAddressForms(pubkey, "BTC")
+	if err != nil {
+		t.Fatal("all address forms failed:", err)
+	}
+	if len(forms) != len(want) {
+		t.Errorf("got %d forms, want %d: %v", len(forms), len(want), forms)
+	}
+	for kind, address := range want {
+		if forms[kind] != address {
+			t.Errorf("%s: got %s, want %s", kind, forms[kind], address)
+		}
+	}
+
+	// litecoin has no taproot form
+	forms, err = AllAddressForms(pubkey, "LTC")
+	if err != nil || len(forms) != 3 || forms[BitcoinP2SHP2WPKH] != "MR8UQSBr5ULwWheBHznrHk2jxyxkHQu8vB" || forms[BitcoinP2WPKH] != "ltc1qw508d6qejxtdg4y5r3zarvary0c5xw7kgmn4n9" {
+		t.Errorf("litecoin forms: %v, %v", forms, err)
+	}
+
+	uncompressed, _ := hex.DecodeString("0479be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b8")
+	forms, err = AllAddressForms(uncompressed, "BTC")
+	if err != nil || len(forms) != 1 || forms[BitcoinP2PKH] != "1EHNa6Q4Jz2uvNExL497mE43ikXhwF6kZm" {
+		t.Errorf("uncompressed key forms: %v, %v", forms, err)
+	}
+
+	if _, err := AllAddressForms(pubkey, "XYZ"); err != ErrorUnknownCoin {
+		t.Error("unknown coin:", err)
+	}
+	if _, err := AllAddressForms(pubkey[:32], "BTC"); err != ErrorInvalidPubKey {
+		t.Error("short key:", err)
+	}
+}
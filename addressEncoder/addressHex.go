@@ -0,0 +1,38 @@
+package addressEncoder
+
+import (
+	"errors"
+	"strings"
+)
+
+var (
+	ErrorOddHexLength = errors.New("Odd length hex in address!")
+)
+
+// LenientHexDecode decodes an address of the ICX or hex encode type whose hex lost its leading zero digit, as
+// happens when it went through a number: hex after the text prefix that is one digit short is left padded
+// with a zero before it is decoded with AddressDecode. Only that single nibble is restored, hex that is any
+// shorter or too long fails with ErrorInvalidHashLength, other encode types with ErrorInvalidAddress.
+func LenientHexDecode(address string, addresstype AddressType) ([]byte, error) {
+	var prefix string
+	var digits int
+	switch addresstype.EncodeType {
+	case "ICX":
+		prefix, digits = "hx", 2*addresstype.HashLen
+	case "hex":
+		prefix, digits = addresstype.ChecksumType, 2*(len(addresstype.Prefix)+addresstype.HashLen)
+	default:
+		return nil, ErrorInvalidAddress
+	}
+	if !strings.HasPrefix(address, prefix) {
+		return nil, ErrorInvalidAddress
+	}
+	material := address[len(prefix):]
+	switch len(material) {
+	case digits:
+		return AddressDecode(address, addresstype)
+	case digits - 1:
+		return AddressDecode(prefix+"0"+material, addresstype)
+	}
+	return nil, ErrorInvalidHashLength
+}
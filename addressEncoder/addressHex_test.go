@@ -0,0 +1,55 @@
+package addressEncoder
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+func Test_ICX_hex_length(t *testing.T) {
+	hash, _ := hex.DecodeString("0a4c6e8b5e7f2c1d3b9a8f7e6d5c4b3a29181716")
+	full := "hx" + hex.EncodeToString(hash)
+	tests := []struct {
+		address    string
+		err        error
+		lenientErr error
+	}{
+		{full[:2] + full[3:], ErrorOddHexLength, nil}, // 39 hex characters, the leading zero lost
+		{full, nil, nil}, // 40 hex characters
+		{full + "0", ErrorOddHexLength, ErrorInvalidHashLength},               // 41 hex characters
+		{full[:2] + full[4:], ErrorInvalidHashLength, ErrorInvalidHashLength}, // 38 hex characters, a whole byte short
+		{"hx1", ErrorOddHexLength, ErrorInvalidHashLength},
+		{"hx", ErrorInvalidHashLength, ErrorInvalidHashLength},
+	}
+	for _, test := range tests {
+		ret, err := AddressDecode(test.address, ICX_walletAddress)
+		if err != test.err || (err == nil && !bytes.Equal(ret, hash)) {
+			t.Errorf("decode %s: got %x, %v, want %v", test.address, ret, err, test.err)
+		}
+		ret, err = LenientHexDecode(test.address, ICX_walletAddress)
+		if err != test.lenientErr {
+			t.Errorf("lenient decode %s: got %v, want %v", test.address, err, test.lenientErr)
+		}
+		if err == nil && !bytes.Equal(ret, hash) {
+			t.Errorf("lenient decode %s: got %x", test.address, ret)
+		}
+	}
+
+	// the hex encode type, with the one byte tag of casper in front of the key
+	key := make([]byte, 32)
+	key[0] = 0x05
+	address := AddressEncode(key, CSPR_publicKeyEd25519)
+	if _, err := AddressDecode(address[:len(address)-1], CSPR_publicKeyEd25519); err != ErrorOddHexLength {
+		t.Error("odd casper hex:", err)
+	}
+	if ret, err := LenientHexDecode(address[1:], CSPR_publicKeyEd25519); err != nil || !bytes.Equal(ret, key) {
+		t.Errorf("lenient casper decode: %x, %v", ret, err)
+	}
+
+	if _, err := LenientHexDecode("1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", BTC_mainnetAddressP2PKH); err != ErrorInvalidAddress {
+		t.Error("lenient decode of base58:", err)
+	}
+	if _, err := AddressDecode("h", ICX_walletAddress); err != ErrorInvalidAddress {
+		t.Error("short ICX address:", err)
+	}
+}
@@ -0,0 +1,97 @@
+package addressEncoder
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+)
+
+var (
+	ErrorVariablePrefix = errors.New("Address type has no fixed prefix length!")
+)
+
+// xmrBlockLengths is the number of characters monero gives a final block of 0 to 8 bytes, full blocks take 11
+var xmrBlockLengths = []int{0, 2, 3, 5, 6, 7, 9, 10, 11}
+
+// base58LengthRange returns the length of the shortest and the longest base58 encoding of prefix, a hash of
+// hashLen bytes, suffix and a checksum of checksumLen bytes. Each leading zero byte becomes its own
+// character, so a hash and checksum of zeros give the shortest.
+func base58LengthRange(prefix []byte, hashLen int, suffix []byte, checksumLen int) (int, int) {
+	alphabet := NewBase58Alphabet(BTCAlphabet)
+	fill := func(b byte) []byte {
+		data := catData(prefix, bytes.Repeat([]byte{b}, hashLen))
+		return catData(catData(data, suffix), bytes.Repeat([]byte{b}, checksumLen))
+	}
+	return len(Base58Encode(fill(0x00), alphabet)), len(Base58Encode(fill(0xff), alphabet))
+}
+
+// groups5 returns the number of 5-bit groups n bytes take
+func groups5(n int) int {
+	return (n*8 + 4) / 5
+}
+
+// ExpectedLength returns the shortest and longest string an address of addresstype can be, computed from
+// its HashLen, prefix, suffix and checksum length, for input limits and a quick check before decoding.
+// Base58 strings get shorter with every leading zero byte, so the range may include lengths no real
+// address has; the other encodings give one length, or one for each of the HashLens of a bech32 type.
+// Eip55 addresses are 40 characters as this package encodes them and 42 with the 0x they are usually
+// given with. The length of a prefix picked by PrefixLen or PrefixFunc is
+// not known before decoding, ErrorVariablePrefix is returned for those address types.
+func ExpectedLength(addresstype AddressType) (int, int, error) {
+	if addresstype.HashLen <= 0 {
+		return 0, 0, ErrorInvalidHashLength
+	}
+	if addresstype.PrefixLen != nil || addresstype.PrefixFunc != nil {
+		return 0, 0, ErrorVariablePrefix
+	}
+	encodeType := addresstype.EncodeType
+	if strings.EqualFold(encodeType, "eos") || strings.EqualFold(encodeType, "aeternity") {
+		encodeType = strings.ToLower(encodeType)
+	}
+	checksumLen := checksumLength(addresstype.ChecksumType)
+	switch encodeType {
+	case "base58":
+		min, max := base58LengthRange(addresstype.Prefix, addresstype.HashLen, addresstype.Suffix, checksumLen)
+		return min, max, nil
+	case "eos", "aeternity":
+		// the prefix is text put in front of the base58 of the hash and checksum
+		min, max := base58LengthRange(nil, addresstype.HashLen, nil, checksumLen)
+		return len(addresstype.Prefix) + min, len(addresstype.Prefix) + max, nil
+	case "XMR":
+		n := len(addresstype.Prefix) + addresstype.HashLen + 4
+		l := n/8*11 + xmrBlockLengths[n%8]
+		return l, l, nil
+	case "bech32":
+		// the types with HashLens take programs of each of those lengths
+		lengths := hashLengths(addresstype)
+		min, max := -1, 0
+		for _, hashLen := range lengths {
+			l := len(addresstype.ChecksumType) + 1 + groups5(hashLen) + len(addresstype.Prefix) + 6
+			if min < 0 || l < min {
+				min = l
+			}
+			if l > max {
+				max = l
+			}
+		}
+		return min, max, nil
+	case "iota":
+		l := len(addresstype.ChecksumType) + 1 + groups5(len(addresstype.Prefix)+addresstype.HashLen) + 6
+		return l, l, nil
+	case "base32PolyMod", "kaspa":
+		l := len(addresstype.ChecksumType) + 1 + groups5(len(addresstype.Prefix)+addresstype.HashLen) + 8
+		return l, l, nil
+	case "filecoin":
+		l := len(addresstype.Prefix) + groups5(addresstype.HashLen+checksumLen)
+		return l, l, nil
+	case "eip55":
+		return 40, 42, nil
+	case "ICX":
+		l := len(addresstype.ChecksumType) + addresstype.HashLen*2
+		return l, l, nil
+	case "hex":
+		l := len(addresstype.ChecksumType) + (len(addresstype.Prefix)+addresstype.HashLen)*2
+		return l, l, nil
+	}
+	return 0, 0, ErrorInvalidAddress
+}
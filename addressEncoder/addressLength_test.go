@@ -0,0 +1,80 @@
+package addressEncoder
+
+import (
+	"bytes"
+	"testing"
+)
+
+func Test_ExpectedLength(t *testing.T) {
+	tests := []struct {
+		addresstype AddressType
+		min, max    int
+	}{
+		{BTC_mainnetAddressP2PKH, 25, 34},
+		{BTC_mainnetAddressP2SH, 34, 34},
+		{BTC_mainnetAddressBech32V0, 42, 62},
+		{BTC_mainnetAddressTaproot, 62, 62},
+		{BTC_mainnetAddressP2WSH, 62, 62},
+		{ATOM_mainnetAddress, 45, 65},
+		{ETH_mainnetPublicAddress, 40, 42},
+		{BCH_mainnetAddressCash, 54, 54},
+		{XMR_mainnetPublicAddress, 95, 95},
+		{XMR_mainnetPublicIntegratedAddress, 106, 106},
+	}
+	for _, test := range tests {
+		min, max, err := ExpectedLength(test.addresstype)
+		if err != nil || min != test.min || max != test.max {
+			t.Errorf("%+v: got %d..%d %v, want %d..%d", test.addresstype, min, max, err, test.min, test.max)
+		}
+	}
+
+	// the length of real addresses falls in the range
+	for _, address := range []string{"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", "1111111111111111111114oLvT2", "3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy"} {
+		min, max, _ := ExpectedLength(BTC_mainnetAddressP2PKH)
+		if address[0] == '3' {
+			min, max, _ = ExpectedLength(BTC_mainnetAddressP2SH)
+		}
+		if len(address) < min || len(address) > max {
+			t.Errorf("%s: %d characters, outside %d..%d", address, len(address), min, max)
+		}
+	}
+
+	// encoded hashes of zeros and of 0xff bytes fall in the range of each preset
+	presets := []AddressType{
+		BTC_mainnetAddressP2PKH, BTC_testnetAddressP2SH, LTC_mainnetAddressBech32V0, LTC_mainnetAddressMWEB,
+		ZEC_mainnet_t_AddressP2PKH, XTZ_mainnetAddress_tz1, ATOM_mainnetAddress, BCH_mainnetAddressCash,
+		ICX_walletAddress, EOS_mainnetPublic, AE_mainnetAddress, FIL_mainnetAddressBLS, IOTA_mainnetAddressEd25519,
+		XMR_testnetPublicSubAddress, ETH_mainnetPublicAddress,
+	}
+	for _, addresstype := range presets {
+		min, max, err := ExpectedLength(addresstype)
+		if err != nil {
+			t.Errorf("%+v: %v", addresstype, err)
+			continue
+		}
+		for _, b := range []byte{0x00, 0xff} {
+			hash := bytes.Repeat([]byte{b}, addresstype.HashLen)
+			if addresstype.EncodeType == "base32PolyMod" {
+				hash[0] = 0
+			}
+			address := AddressEncode(hash, addresstype)
+			if len(address) < min || len(address) > max {
+				t.Errorf("%s: %d characters, outside %d..%d", address, len(address), min, max)
+			}
+		}
+	}
+
+	if _, _, err := ExpectedLength(AddressType{EncodeType: "unknown", HashLen: 20}); err == nil {
+		t.Error("unknown encode type given a length")
+	}
+	variable := BTC_mainnetAddressP2PKH
+	variable.PrefixLen = func([]byte) int { return 1 }
+	if _, _, err := ExpectedLength(variable); err != ErrorVariablePrefix {
+		t.Error("PrefixLen type given a length:", err)
+	}
+	variable = BTC_mainnetAddressP2PKH
+	variable.PrefixFunc = func([]byte) []byte { return []byte{0x00} }
+	if _, _, err := ExpectedLength(variable); err != ErrorVariablePrefix {
+		t.Error("PrefixFunc type given a length:", err)
+	}
+}
@@ -0,0 +1,74 @@
+package addressEncoder
+
+import "strings"
+
+// DecodeResult is what AddressDecodeResult tells about an address, only the fields the encoding has are set.
+// A DecodeResult does not mean the address is valid: check ChecksumValid before using the Hash.
+type DecodeResult struct {
+	Hash          []byte // the data AddressDecode gives, without the cashaddr version byte
+	Prefix        []byte // the version bytes of base58, the witness version group of bech32
+	Version       int    // the witness version of segwit addresses, the type of cashaddr
+	HRP           string // the human readable part of bech32 and the prefix of cashaddr, in lower case
+	ChecksumValid bool   // false for base58 addresses decoded past a wrong checksum and for eip55 addresses without a valid checksum
+}
+
+// AddressDecodeResult decodes address like AddressDecode and returns all it carries in one go.
+// Unlike AddressDecode, err == nil does not mean the address is valid: a base58 address with a wrong
+// checksum is decoded past it as AddressDecodeLenient does, with ChecksumValid false. Eip55 addresses
+// in a single case have no checksum and are decoded with ChecksumValid false as well.
+func AddressDecodeResult(address string, addresstype AddressType) (*DecodeResult, error) {
+	ret := &DecodeResult{ChecksumValid: true}
+	switch addresstype.EncodeType {
+	case "base58":
+		if err := checkASCII(address); err != nil {
+			return nil, err
+		}
+		prefix, hash, err := AddressDecodePrefix(address, addresstype)
+		if err != nil {
+			if hash, err = decodePastChecksum(address, addresstype, err); err == nil {
+				prefix = addresstype.Prefix
+				ret.ChecksumValid = false
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		ret.Prefix, ret.Hash = prefix, hash
+	case "bech32":
+		hash, err := AddressDecode(address, addresstype)
+		if err != nil {
+			return nil, err
+		}
+		ret.Hash = hash
+		ret.HRP = strings.ToLower(address[:strings.LastIndexByte(address, '1')])
+		if len(addresstype.Prefix) > 0 {
+			ret.Prefix = addresstype.Prefix
+			ret.Version = int(addresstype.Prefix[0])
+		}
+	case "base32PolyMod":
+		data, err := AddressDecode(address, addresstype)
+		if err != nil {
+			return nil, err
+		}
+		ret.HRP = strings.ToLower(addresstype.ChecksumType)
+		if len(addresstype.Prefix) == 1 {
+			ret.Hash, ret.Version = data, int(addresstype.Prefix[0])
+		} else {
+			ret.Hash, ret.Version = data[1:], int(data[0]>>3)
+		}
+	case "eip55":
+		hash, err := AddressDecode(address, addresstype)
+		if err != nil {
+			return nil, err
+		}
+		ret.Hash = hash
+		ret.ChecksumValid = eip55Checksum(hash) == "0x"+strings.TrimPrefix(address, "0x")
+	default:
+		hash, err := AddressDecode(address, addresstype)
+		if err != nil {
+			return nil, err
+		}
+		ret.Hash, ret.Prefix = hash, addresstype.Prefix
+	}
+	return ret, nil
+}
@@ -0,0 +1,55 @@
+package addressEncoder
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+func Test_AddressDecodeResult(t *testing.T) {
+	hash, _ := hex.DecodeString("751e76e8199196d454941c45d1b3a323f1433bd6")
+
+	// base58
+	ret, err := AddressDecodeResult("1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH", BTC_mainnetAddressP2PKH)
+	if err != nil || !bytes.Equal(ret.Hash, hash) || !bytes.Equal(ret.Prefix, []byte{0x00}) || !ret.ChecksumValid || ret.HRP != "" {
+		t.Errorf("base58: %+v, %v", ret, err)
+	}
+	ret, err = AddressDecodeResult("1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMJ", BTC_mainnetAddressP2PKH)
+	if err != nil || !bytes.Equal(ret.Hash, hash) || ret.ChecksumValid {
+		t.Errorf("base58 with a wrong checksum: %+v, %v", ret, err)
+	}
+	// the same recovery as AddressDecodeLenient
+	if lenient, ok, err := AddressDecodeLenient("1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMJ", BTC_mainnetAddressP2PKH); err != nil || ok || !bytes.Equal(lenient, ret.Hash) {
+		t.Errorf("lenient decode differs: %x, %v, %v", lenient, ok, err)
+	}
+	if _, err := AddressDecodeResult("1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH", LTC_mainnetAddressP2PKH); err == nil {
+		t.Error("base58 of another version decoded")
+	}
+
+	// bech32
+	ret, err = AddressDecodeResult("BC1QW508D6QEJXTDG4Y5R3ZARVARY0C5XW7KV8F3T4", BTC_mainnetAddressBech32V0)
+	if err != nil || !bytes.Equal(ret.Hash, hash) || ret.HRP != "bc" || ret.Version != 0 || !ret.ChecksumValid {
+		t.Errorf("bech32: %+v, %v", ret, err)
+	}
+	ret, err = AddressDecodeResult("bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj0", BTC_mainnetAddressTaproot)
+	if err != nil || len(ret.Hash) != 32 || ret.HRP != "bc" || ret.Version != 1 {
+		t.Errorf("taproot: %+v, %v", ret, err)
+	}
+
+	// cashaddr
+	ret, err = AddressDecodeResult("bitcoincash:ppm2qsznhks23z7629mms6s4cwef74vcwvn0h829pq", BCH_mainnetAddressCash)
+	if err != nil || hex.EncodeToString(ret.Hash) != "76a04053bda0a88bda5177b86a15c3b29f559873" || ret.HRP != "bitcoincash" || ret.Version != CashAddrP2SH {
+		t.Errorf("cashaddr: %+v, %v", ret, err)
+	}
+
+	// eip55
+	ret, err = AddressDecodeResult("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", ETH_mainnetPublicAddress)
+	if err != nil || hex.EncodeToString(ret.Hash) != "5aaeb6053f3e94c9b9a09f33669435e7ef1beaed" || !ret.ChecksumValid || ret.Prefix != nil {
+		t.Errorf("eip55: %+v, %v", ret, err)
+	}
+	for _, address := range []string{"0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD"} {
+		if ret, err = AddressDecodeResult(address, ETH_mainnetPublicAddress); err != nil || ret.ChecksumValid {
+			t.Errorf("eip55 without a valid checksum %s: %+v, %v", address, ret, err)
+		}
+	}
+}
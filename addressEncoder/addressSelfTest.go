@@ -0,0 +1,70 @@
+package addressEncoder
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"strings"
+)
+
+var (
+	ErrorSelfTestRoundTrip = errors.New("Address type does not decode what it encodes!")
+	ErrorSelfTestTampered  = errors.New("Address type accepts a tampered address!")
+)
+
+// selfTestHashLen returns the length of the data that AddressDecode gives back for addresstype
+func selfTestHashLen(addresstype AddressType) int {
+	if addresstype.EncodeType == "eip55" {
+		return 20
+	}
+	return addresstype.HashLen
+}
+
+// SelfTest checks that at is consistent, for a new coin configuration in a package init or a test: a random
+// hash of a valid length must encode and decode back to itself, and the address with one character of its
+// data changed must fail to decode. The eip55, ICX and hex encode types have no checksum and skip the second check.
+func (at AddressType) SelfTest() error {
+	n := selfTestHashLen(at)
+	if n <= 0 {
+		return ErrorInvalidHashLength
+	}
+	hash := make([]byte, n)
+	if _, err := rand.Read(hash); err != nil {
+		return err
+	}
+	if at.EncodeType == "base32PolyMod" && len(at.Prefix) != 1 {
+		// the leading byte is the cashaddr type, which decodes as the version byte of a 20-byte hash
+		hash[0] = 0
+	}
+
+	address, err := EncodeHash(hash, at)
+	if err != nil {
+		return err
+	}
+	data, err := AddressDecode(address, at)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(data, hash) {
+		return ErrorSelfTestRoundTrip
+	}
+
+	switch at.EncodeType {
+	case "eip55", "ICX", "hex":
+		return nil
+	}
+	// the middle character is past any text prefix and part of the checksummed data
+	pos := len(address) / 2
+	i := strings.IndexByte(at.Alphabet, address[pos])
+	if i < 0 {
+		return ErrorSelfTestRoundTrip
+	}
+	if i++; i == len(at.Alphabet) {
+		i -= 2
+	}
+	tampered := address[:pos] + at.Alphabet[i:i+1] + address[pos+1:]
+	if _, err := AddressDecode(tampered, at); err == nil {
+		return ErrorSelfTestTampered
+	}
+	return nil
+}
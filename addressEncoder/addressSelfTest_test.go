@@ -0,0 +1,47 @@
+package addressEncoder
+
+import (
+	"testing"
+)
+
+func Test_SelfTest(t *testing.T) {
+	presets := map[string]AddressType{
+		"BTC_mainnetAddressTaproot":          BTC_mainnetAddressTaproot,
+		"BTC_mainnetPrivateWIFCompressed":    BTC_mainnetPrivateWIFCompressed,
+		"BCH_mainnetAddressCashTokenP2SH":    BCH_mainnetAddressCashTokenP2SH,
+		"XMR_mainnetPublicIntegratedAddress": XMR_mainnetPublicIntegratedAddress,
+		"KAS_mainnetAddressECDSA":            KAS_mainnetAddressECDSA,
+		"CSPR_publicKeySecp256k1":            CSPR_publicKeySecp256k1,
+		"LTC_mainnetAddressMWEB":             LTC_mainnetAddressMWEB,
+	}
+	for coin, addresstype := range Coins {
+		presets[coin] = addresstype
+	}
+	for name, addresstype := range presets {
+		// the hash is random, repeat to cover more of them
+		for i := 0; i < 20; i++ {
+			if err := addresstype.SelfTest(); err != nil {
+				t.Errorf("%s: %v", name, err)
+				break
+			}
+		}
+	}
+
+	unknownChecksum := BTC_mainnetAddressP2PKH
+	unknownChecksum.ChecksumType = "sha1"
+	badPrefixLen := BTC_mainnetAddressP2PKH
+	badPrefixLen.PrefixLen = func([]byte) int { return 2 }
+	badBech32 := BTC_mainnetAddressBech32V0
+	badBech32.HashLen = 25
+	broken := map[string]AddressType{
+		"unknown checksum type": unknownChecksum,
+		"wrong prefix length":   badPrefixLen,
+		"bech32 hash length":    badBech32,
+		"no hash length":        {EncodeType: "base58", Alphabet: BTCAlphabet, ChecksumType: "doubleSHA256"},
+	}
+	for name, addresstype := range broken {
+		if err := addresstype.SelfTest(); err == nil {
+			t.Errorf("%s: self test passed", name)
+		}
+	}
+}
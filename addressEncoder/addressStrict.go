@@ -0,0 +1,230 @@
+package addressEncoder
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+
+	"github.com/blocktree/go-owcdrivers/addressEncoder/base32PolyMod"
+	"github.com/blocktree/go-owcdrivers/addressEncoder/bech32"
+)
+
+var (
+	ErrorNotCanonical   = errors.New("Address is not in canonical form!")
+	ErrorChecksumNeeded = errors.New("Encode type can not be decoded without checksum!")
+)
+
+// canonicalAddress re-encodes the data decoded from an address of addresstype
+func canonicalAddress(data []byte, addresstype AddressType) string {
+	switch addresstype.EncodeType {
+	case "eip55":
+		return eip55Checksum(data)
+	case "base32PolyMod":
+		if len(addresstype.Prefix) == 1 {
+			break
+		}
+		// decode gives the version byte while encode takes the type it is built from
+		payload := make([]byte, len(data))
+		copy(payload, data)
+		payload[0] >>= 3
+		return AddressEncode(payload, addresstype)
+	}
+	return AddressEncode(data, addresstype)
+}
+
+// DecodeStrict works like AddressDecode but only accepts the canonical form of an address,
+// the exact string AddressEncode gives back for the decoded data: no overlong encodings and
+// lowercase bech32 and cashaddr with its prefix. The canonical form of eip55 is the one of eip55Checksum,
+// 0x prefixed with the checksum casing, and not the lowercase hex without 0x AddressEncode gives.
+// ErrorNotCanonical is returned for any other spelling of a valid address. For an addresstype with PrefixLen
+// set the address is re-encoded with the prefix it carries, PrefixFunc computes it from the data as it does
+// in AddressEncode.
+func DecodeStrict(address string, addresstype AddressType) ([]byte, error) {
+	prefix, data, err := AddressDecodePrefix(address, addresstype)
+	if err != nil {
+		return nil, err
+	}
+	if addresstype.PrefixLen != nil {
+		addresstype = addresstype.WithPrefix(prefix)
+	}
+	if canonicalAddress(data, addresstype) != address {
+		return nil, ErrorNotCanonical
+	}
+	return data, nil
+}
+
+// AddressesEqual reports whether a and b are two spellings of the same address of addresstype by comparing
+// the decoded data, so the eip55 checksum casing, the case of bech32 and cashaddr and a left out cashaddr
+// prefix make no difference. The first decode error is returned.
+func AddressesEqual(a, b string, addresstype AddressType) (bool, error) {
+	dataA, err := AddressDecode(a, addresstype)
+	if err != nil {
+		return false, err
+	}
+	dataB, err := AddressDecode(b, addresstype)
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(dataA, dataB), nil
+}
+
+// DecodeNoChecksum works like AddressDecode but does not verify the checksum, for showing what a mistyped
+// address holds. The prefix, suffix and hash length are still enforced. The base58 encodings, including the
+// eos and aeternity ones after their text prefix, have their checksum bytes stripped. The trailing checksum
+// characters of bech32 and iota, 6 of them, and of cashaddr and kaspa, 8 of them, are computed again from
+// the rest of the address before it is decoded, and an eip55 address is taken in any case. ICX and hex
+// addresses have no checksum and are decoded as they are. ErrorChecksumNeeded is returned for the other
+// encode types.
+func DecodeNoChecksum(address string, addresstype AddressType) ([]byte, error) {
+	material := address
+	switch {
+	case addresstype.EncodeType == "base58":
+	case strings.EqualFold(addresstype.EncodeType, "eos"), strings.EqualFold(addresstype.EncodeType, "aeternity"):
+		if !strings.HasPrefix(address, string(addresstype.Prefix)) {
+			return nil, ErrorInvalidAddress
+		}
+		material = address[len(addresstype.Prefix):]
+		addresstype.Prefix = nil
+	case addresstype.EncodeType == "bech32", addresstype.EncodeType == "iota",
+		addresstype.EncodeType == "base32PolyMod", addresstype.EncodeType == "kaspa":
+		return decodeWithChecksumRebuilt(address, addresstype)
+	case addresstype.EncodeType == "eip55":
+		if IsENSName(address) {
+			return nil, ErrorENSName
+		}
+		addresstype.CaseFold = CaseFoldNone
+		return AddressDecode(strings.ToLower(address), addresstype)
+	case addresstype.EncodeType == "ICX", addresstype.EncodeType == "hex":
+		return AddressDecode(address, addresstype)
+	default:
+		return nil, ErrorChecksumNeeded
+	}
+
+	decoded, err := Base58Decode(material, NewBase58Alphabet(addresstype.Alphabet))
+	if err != nil || len(decoded) < checksumLength(addresstype.ChecksumType) {
+		return nil, ErrorInvalidAddress
+	}
+	decoded = decoded[:len(decoded)-checksumLength(addresstype.ChecksumType)]
+	prefix := addresstype.Prefix
+	if addresstype.PrefixLen != nil {
+		n := addresstype.PrefixLen(decoded)
+		if n < 0 || n > len(decoded) {
+			return nil, ErrorInvalidAddress
+		}
+		prefix = decoded[:n]
+	}
+	data, err := recoverData(decoded, prefix, addresstype.Suffix)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkLength(len(data), addresstype); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// AddressDecodeLenient decodes address like AddressDecode but does not fail on a bad checksum: the data is
+// recovered as DecodeNoChecksum does and checksumOK tells whether the checksum matched, for recovery tools.
+// err is only set for an address that does not decode at all, such as bad base58 or another prefix.
+// The encode types DecodeNoChecksum returns ErrorChecksumNeeded for are decoded strictly.
+func AddressDecodeLenient(address string, addresstype AddressType) (hash []byte, checksumOK bool, err error) {
+	hash, err = AddressDecode(address, addresstype)
+	if err == nil {
+		return hash, true, nil
+	}
+	if hash, err = decodePastChecksum(address, addresstype, err); err != nil {
+		return nil, false, err
+	}
+	return hash, false, nil
+}
+
+// decodePastChecksum is the fallback of the lenient decoders once the strict decode failed with err: the
+// data is recovered as DecodeNoChecksum does, and err is kept for the encode types it can not handle.
+func decodePastChecksum(address string, addresstype AddressType, err error) ([]byte, error) {
+	hash, lenientErr := DecodeNoChecksum(address, addresstype)
+	if lenientErr == ErrorChecksumNeeded {
+		return nil, err
+	}
+	if lenientErr != nil {
+		return nil, lenientErr
+	}
+	return hash, nil
+}
+
+// bech32Constants are the values the polymod of a valid bech32 and bech32m string comes to
+var bech32Constants = []uint32{1, 0x2bc830a3}
+
+// bech32ChecksumChars gives the 6 checksum characters that bring the polymod of hrp and the 5-bit values of
+// the data part to constant
+func bech32ChecksumChars(hrp string, values []int, constant uint32, alphabet string) string {
+	expanded := make([]int, 0, len(hrp)*2+1+len(values)+6)
+	for i := 0; i < len(hrp); i++ {
+		expanded = append(expanded, int(hrp[i]>>5))
+	}
+	expanded = append(expanded, 0)
+	for i := 0; i < len(hrp); i++ {
+		expanded = append(expanded, int(hrp[i]&31))
+	}
+	expanded = append(expanded, values...)
+	expanded = append(expanded, 0, 0, 0, 0, 0, 0)
+	checksum := bech32.Polymod(expanded, bech32.Generators()) ^ constant
+	ret := make([]byte, 6)
+	for i := range ret {
+		ret[i] = alphabet[checksum>>uint(5*(5-i))&31]
+	}
+	return string(ret)
+}
+
+// decodeWithChecksumRebuilt drops the trailing checksum characters of a bech32, iota, cashaddr or kaspa
+// address, puts the ones the rest of the address gives in their place and decodes the result with
+// AddressDecode. Bech32 is tried with the checksum of both variants, as the witness version picks one.
+func decodeWithChecksumRebuilt(address string, addresstype AddressType) ([]byte, error) {
+	if err := checkASCII(address); err != nil {
+		return nil, err
+	}
+	address = strings.ToLower(address)
+	sep, checksumLen := byte('1'), 6
+	if addresstype.EncodeType == "base32PolyMod" || addresstype.EncodeType == "kaspa" {
+		sep, checksumLen = ':', 8
+		// AddressDecode would take a cashaddr without prefix as well, but the checksum is computed over it
+		if addresstype.EncodeType == "base32PolyMod" && strings.IndexByte(address, ':') < 0 {
+			address = strings.ToLower(addresstype.ChecksumType) + ":" + address
+		}
+	}
+	pos := strings.LastIndexByte(address, sep)
+	if pos < 1 || len(address)-pos-1 <= checksumLen {
+		return nil, ErrorInvalidAddress
+	}
+	hrp, data := address[:pos], address[pos+1:len(address)-checksumLen]
+	values := make([]byte, len(data))
+	for i := 0; i < len(data); i++ {
+		v := strings.IndexByte(addresstype.Alphabet, data[i])
+		if v < 0 {
+			return nil, ErrorInvalidAddress
+		}
+		values[i] = byte(v)
+	}
+
+	if sep == ':' {
+		payload, err := bech32.ConvertBits(values, 5, 8, false)
+		if err != nil {
+			return nil, ErrorInvalidAddress
+		}
+		return AddressDecode(base32PolyMod.EncodePayload(hrp, addresstype.Alphabet, payload), addresstype)
+	}
+	ints := make([]int, len(values))
+	for i, v := range values {
+		ints[i] = int(v)
+	}
+	var firstErr error
+	for _, constant := range bech32Constants {
+		ret, err := AddressDecode(address[:pos+1]+data+bech32ChecksumChars(hrp, ints, constant, addresstype.Alphabet), addresstype)
+		if err == nil {
+			return ret, nil
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	return nil, firstErr
+}
@@ -0,0 +1,226 @@
+package addressEncoder
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+func Test_DecodeStrict(t *testing.T) {
+	canonical := []struct {
+		address     string
+		addresstype AddressType
+	}{
+		{"19xD3nnvEiu7Uqd8irRvF3j5ExLb4ZtSju", BTC_mainnetAddressP2PKH},
+		{"3BYx8ciMdywxd2bbn5h9V7EAZtzLg2RhhX", BTC_mainnetAddressP2SH},
+		{"bc1qvgclzqz7smqr6haag9mknpwsjnxtdqkncr64kd", BTC_mainnetAddressBech32V0},
+		{"bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj0", BTC_mainnetAddressTaproot},
+		{"bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a", BCH_mainnetAddressCash},
+		{"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", ETH_mainnetPublicAddress},
+	}
+	for _, c := range canonical {
+		if _, err := DecodeStrict(c.address, c.addresstype); err != nil {
+			t.Errorf("canonical address %s rejected: %v", c.address, err)
+		}
+	}
+
+	nonCanonical := []struct {
+		address     string
+		addresstype AddressType
+	}{
+		{"BC1QVGCLZQZ7SMQR6HAAG9MKNPWSJNXTDQKNCR64KD", BTC_mainnetAddressBech32V0},
+		{"BITCOINCASH:QPM2QSZNHKS23Z7629MMS6S4CWEF74VCWVY22GDX6A", BCH_mainnetAddressCash},
+		{"0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", ETH_mainnetPublicAddress},
+		{"0x5AAEB6053F3E94C9B9A09F33669435E7EF1BEAED", ETH_mainnetPublicAddress},
+		{"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD", ETH_mainnetPublicAddress},
+		{"5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", ETH_mainnetPublicAddress},
+	}
+	for _, c := range nonCanonical {
+		if _, err := AddressDecode(c.address, c.addresstype); err != nil {
+			t.Errorf("%s should be decodable: %v", c.address, err)
+		}
+		if _, err := DecodeStrict(c.address, c.addresstype); err != ErrorNotCanonical {
+			t.Errorf("non canonical address %s accepted: %v", c.address, err)
+		}
+	}
+
+	// the lowercase eip55 form AddressEncode gives is not the canonical one
+	hash, _ := hex.DecodeString("5aaeb6053f3e94c9b9a09f33669435e7ef1beaed")
+	plain, err := EncodeHash(hash, ETH_mainnetPublicAddress)
+	if err != nil || plain != "5aaeb6053f3e94c9b9a09f33669435e7ef1beaed" {
+		t.Errorf("EncodeHash: got %s, %v", plain, err)
+	}
+	if _, err := DecodeStrict(plain, ETH_mainnetPublicAddress); err != ErrorNotCanonical {
+		t.Errorf("%s: got %v, want ErrorNotCanonical", plain, err)
+	}
+
+	if _, err := DecodeStrict("19xD3nnvEiu7Uqd8irRvF3j5ExLb4ZtSjv", BTC_mainnetAddressP2PKH); err == nil {
+		t.Error("invalid address accepted")
+	}
+
+	// the prefix of a PrefixLen type comes from the address, the one of a PrefixFunc type from the data
+	anyVersion := BTC_mainnetAddressP2PKH
+	anyVersion.Prefix = nil
+	anyVersion.PrefixLen = func([]byte) int { return 1 }
+	byData := BTC_mainnetAddressP2PKH
+	byData.Prefix = nil
+	byData.PrefixFunc = func([]byte) []byte { return []byte{0x05} }
+	for _, c := range []struct {
+		address     string
+		addresstype AddressType
+	}{
+		{"19xD3nnvEiu7Uqd8irRvF3j5ExLb4ZtSju", anyVersion},
+		{"3BYx8ciMdywxd2bbn5h9V7EAZtzLg2RhhX", anyVersion},
+		{"3BYx8ciMdywxd2bbn5h9V7EAZtzLg2RhhX", byData},
+	} {
+		if _, err := DecodeStrict(c.address, c.addresstype); err != nil {
+			t.Errorf("canonical address %s rejected: %v", c.address, err)
+		}
+	}
+}
+
+func Test_AddressesEqual(t *testing.T) {
+	equal := []struct {
+		a, b        string
+		addresstype AddressType
+	}{
+		{"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", ETH_mainnetPublicAddress},
+		{"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", "5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", ETH_mainnetPublicAddress},
+		{"bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4", "BC1QW508D6QEJXTDG4Y5R3ZARVARY0C5XW7KV8F3T4", BTC_mainnetAddressBech32V0},
+		{"bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a", "QPM2QSZNHKS23Z7629MMS6S4CWEF74VCWVY22GDX6A", BCH_mainnetAddressCash},
+	}
+	for _, test := range equal {
+		if ok, err := AddressesEqual(test.a, test.b, test.addresstype); !ok || err != nil {
+			t.Errorf("%s and %s: got %v, %v", test.a, test.b, ok, err)
+		}
+	}
+
+	if ok, err := AddressesEqual("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359", ETH_mainnetPublicAddress); ok || err != nil {
+		t.Errorf("different addresses: got %v, %v", ok, err)
+	}
+	if _, err := AddressesEqual("1BpEi6DfDAUFd7GtittLSdBeYJvcoaVggu", "1BpEi6DfDAUFd7GtittLSdBeYJvcoaVggv", BTC_mainnetAddressP2PKH); err == nil {
+		t.Error("invalid second address compared")
+	}
+	// the same payload on another network
+	testnet, _ := LegacyToCashAddr("mrCDrCybB6J1vRfbwM5hemdJz73FwDBC8r")
+	mainnet, _ := LegacyToCashAddr("1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH")
+	if ok, err := AddressesEqual(mainnet, testnet, BCH_mainnetAddressCash); ok || err == nil {
+		t.Errorf("%s and %s: got %v, %v", mainnet, testnet, ok, err)
+	}
+}
+
+func Test_DecodeNoChecksum(t *testing.T) {
+	// the last character changed, only the checksum bytes differ
+	broken := "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN3"
+	if _, err := AddressDecode(broken, BTC_mainnetAddressP2PKH); err == nil {
+		t.Fatal("broken checksum accepted by AddressDecode")
+	}
+	ret, err := DecodeNoChecksum(broken, BTC_mainnetAddressP2PKH)
+	if err != nil || hex.EncodeToString(ret) != "77bff20c60e522dfaa3350c39b030a5d004e839a" {
+		t.Errorf("decoded %x, %v", ret, err)
+	}
+	if ret, err := DecodeNoChecksum("1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", BTC_mainnetAddressP2PKH); err != nil || hex.EncodeToString(ret) != "77bff20c60e522dfaa3350c39b030a5d004e839a" {
+		t.Errorf("valid address decoded %x, %v", ret, err)
+	}
+
+	key := bytes.Repeat([]byte{0x02}, 33)
+	eos := AddressEncode(key, EOS_mainnetPublic)
+	last := "2"
+	if strings.HasSuffix(eos, last) {
+		last = "3"
+	}
+	eos = eos[:len(eos)-1] + last
+	if _, err := AddressDecode(eos, EOS_mainnetPublic); err == nil {
+		t.Error("broken eos checksum accepted by AddressDecode")
+	}
+	if ret, err := DecodeNoChecksum(eos, EOS_mainnetPublic); err != nil || !bytes.Equal(ret, key) {
+		t.Errorf("%s decoded %x, %v", eos, ret, err)
+	}
+
+	// the structure is still checked
+	if _, err := DecodeNoChecksum("mrCDrCybB6J1vRfbwM5hemdJz73FwDBC8r", BTC_mainnetAddressP2PKH); err != ErrorInvalidAddress {
+		t.Error("testnet prefix accepted:", err)
+	}
+	short := AddressEncode(make([]byte, 19), AddressType{EncodeType: "base58", Alphabet: BTCAlphabet, ChecksumType: "doubleSHA256", HashLen: 19, Prefix: []byte{0x00}})
+	if _, err := DecodeNoChecksum(short, BTC_mainnetAddressP2PKH); err != ErrorInvalidHashLength {
+		t.Error("19 bytes hash accepted:", err)
+	}
+
+	// the trailing checksum characters of bech32, cashaddr and kaspa are dropped, eip55 is taken in any case
+	p2wpkh := "751e76e8199196d454941c45d1b3a323f1433bd6"
+	cash := "76a04053bda0a88bda5177b86a15c3b29f559873"
+	for _, test := range []struct {
+		address     string
+		addresstype AddressType
+		hash        string
+	}{
+		{"bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t5", BTC_mainnetAddressBech32V0, p2wpkh},
+		{"BC1QW508D6QEJXTDG4Y5R3ZARVARY0C5XW7KV8F3T5", BTC_mainnetAddressBech32V0, p2wpkh},
+		{"bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj2", BTC_mainnetAddressTaproot, "79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"},
+		{"bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6q", BCH_mainnetAddressCashP2PKH, cash},
+		{"qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6q", BCH_mainnetAddressCashP2PKH, cash},
+		{"0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", ETH_mainnetPublicAddress, "5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"},
+		{"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD", ETH_mainnetPublicAddress, "5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"},
+	} {
+		if ret, err := DecodeNoChecksum(test.address, test.addresstype); err != nil || hex.EncodeToString(ret) != test.hash {
+			t.Errorf("%s decoded %x, %v", test.address, ret, err)
+		}
+	}
+	schnorrKey := bytes.Repeat([]byte{0x5a}, 32)
+	kaspa := AddressEncode(schnorrKey, KAS_mainnetAddressSchnorr)
+	last = "p"
+	if strings.HasSuffix(kaspa, last) {
+		last = "q"
+	}
+	kaspa = kaspa[:len(kaspa)-1] + last
+	if ret, err := DecodeNoChecksum(kaspa, KAS_mainnetAddressSchnorr); err != nil || !bytes.Equal(ret, schnorrKey) {
+		t.Errorf("%s decoded %x, %v", kaspa, ret, err)
+	}
+	// the rest of the address is still checked
+	for address, addresstype := range map[string]AddressType{
+		"tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx":                     BTC_mainnetAddressBech32V0,
+		"bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj2": BTC_mainnetAddressBech32V0,
+		"bchtest:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6q":             BCH_mainnetAddressCashP2PKH,
+		"bitcoincash:ppm2qsznhks23z7629mms6s4cwef74vcwvn0h829pq":         BCH_mainnetAddressCashP2PKH,
+	} {
+		if ret, err := DecodeNoChecksum(address, addresstype); err == nil {
+			t.Errorf("%s decoded %x", address, ret)
+		}
+	}
+	if _, err := DecodeNoChecksum("0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", XMR_mainnetPublicAddress); err != ErrorChecksumNeeded {
+		t.Error("xmr decoded without checksum:", err)
+	}
+}
+
+func Test_AddressDecodeLenient(t *testing.T) {
+	address := "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"
+	want := "77bff20c60e522dfaa3350c39b030a5d004e839a"
+	if ret, ok, err := AddressDecodeLenient(address, BTC_mainnetAddressP2PKH); err != nil || !ok || hex.EncodeToString(ret) != want {
+		t.Errorf("valid address decoded %x, %v, %v", ret, ok, err)
+	}
+
+	// one byte of the checksum corrupted
+	raw, _ := Base58Decode(address, NewBase58Alphabet(BTCAlphabet))
+	raw[len(raw)-2] ^= 0x01
+	corrupted := Base58Encode(raw, NewBase58Alphabet(BTCAlphabet))
+	ret, ok, err := AddressDecodeLenient(corrupted, BTC_mainnetAddressP2PKH)
+	if err != nil || ok || hex.EncodeToString(ret) != want {
+		t.Errorf("%s decoded %x, %v, %v", corrupted, ret, ok, err)
+	}
+
+	if _, _, err := AddressDecodeLenient("1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN0", BTC_mainnetAddressP2PKH); err == nil {
+		t.Error("bad base58 decoded")
+	}
+	if _, _, err := AddressDecodeLenient("mrCDrCybB6J1vRfbwM5hemdJz73FwDBC8r", BTC_mainnetAddressP2PKH); err != ErrorInvalidAddress {
+		t.Error("testnet prefix accepted:", err)
+	}
+
+	// bech32 is decoded past its trailing checksum characters
+	if _, ok, err := AddressDecodeLenient("bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4", BTC_mainnetAddressBech32V0); err != nil || !ok {
+		t.Error("bech32 address failed:", ok, err)
+	}
+	if ret, ok, err := AddressDecodeLenient("bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t5", BTC_mainnetAddressBech32V0); err != nil || ok || hex.EncodeToString(ret) != "751e76e8199196d454941c45d1b3a323f1433bd6" {
+		t.Error("broken bech32 checksum:", ret, ok, err)
+	}
+}
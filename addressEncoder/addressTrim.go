@@ -0,0 +1,64 @@
+package addressEncoder
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+var (
+	ErrorInvalidCharacter  = errors.New("Invalid character found in address!")
+	ErrorNonASCIICharacter = errors.New("Non-ASCII character found in address!")
+)
+
+// isPasteNoise reports whether r is a character that is commonly picked up when
+// an address is copied from a spreadsheet, a chat or a web page: ASCII whitespace
+// and the zero-width unicode characters.
+func isPasteNoise(r rune) bool {
+	switch r {
+	case ' ', '\t', '\n', '\v', '\f', '\r':
+		return true
+	case '\u200b', '\u200c', '\u200d', '\u2060', '\ufeff':
+		return true
+	}
+	return false
+}
+
+// TrimAddress strips the leading and trailing whitespace and zero-width characters of address and reports
+// whether any were stripped, so the caller knows the input was not the address itself. ErrorInvalidCharacter
+// is returned if such characters are also found inside the address.
+func TrimAddress(address string) (string, bool, error) {
+	trimmed := strings.TrimFunc(address, isPasteNoise)
+	if strings.IndexFunc(trimmed, isPasteNoise) != -1 {
+		return "", false, ErrorInvalidCharacter
+	}
+	return trimmed, trimmed != address, nil
+}
+
+// DecodeTrimmed works like AddressDecode but accepts addresses carrying
+// leading or trailing whitespace and zero-width characters, and reports
+// whether any of those were stripped.
+func DecodeTrimmed(address string, addresstype AddressType) ([]byte, bool, error) {
+	trimmed, stripped, err := TrimAddress(address)
+	if err != nil {
+		return nil, false, err
+	}
+	ret, err := AddressDecode(trimmed, addresstype)
+	if err != nil {
+		return nil, false, err
+	}
+	return ret, stripped, nil
+}
+
+// checkASCII returns ErrorNonASCIICharacter, with the code point and byte offset of the first one, if address
+// holds any non-ASCII character, such as a cyrillic letter passed off as a latin one. The alphabets of the
+// address encodings are all ASCII, so these would only fail the alphabet lookup with a less telling error.
+func checkASCII(address string) error {
+	for i, r := range address {
+		if r >= utf8.RuneSelf {
+			return fmt.Errorf("%w U+%04X at offset %d", ErrorNonASCIICharacter, r, i)
+		}
+	}
+	return nil
+}
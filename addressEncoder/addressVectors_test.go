@@ -0,0 +1,37 @@
+package addressEncoder
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func Test_GenerateVectors(t *testing.T) {
+	for _, addresstype := range []AddressType{BTC_mainnetAddressP2PKH, BTC_mainnetAddressBech32V0, BCH_mainnetAddressCash, ETH_mainnetPublicAddress, KAS_mainnetAddressSchnorr} {
+		vectors := GenerateVectors(addresstype, 42)
+		if len(vectors) != vectorCount {
+			t.Errorf("%s: got %d vectors", addresstype.EncodeType, len(vectors))
+			continue
+		}
+		if again := GenerateVectors(addresstype, 42); !reflect.DeepEqual(vectors, again) {
+			t.Errorf("%s: the same seed gave other vectors", addresstype.EncodeType)
+		}
+		if other := GenerateVectors(addresstype, 43); reflect.DeepEqual(vectors, other) {
+			t.Errorf("%s: another seed gave the same vectors", addresstype.EncodeType)
+		}
+		for _, v := range vectors {
+			if hash, err := AddressDecode(v.Address, addresstype); err != nil || !bytes.Equal(hash, v.Hash) {
+				t.Errorf("%s: %s decodes to %x, %v, want %x", addresstype.EncodeType, v.Address, hash, err, v.Hash)
+			}
+		}
+	}
+
+	// pinned so that a change of the random source shows up
+	if vectors := GenerateVectors(BTC_mainnetAddressP2PKH, 1); vectors[0].Address != "18Zpft83eov56iESWuPpV8XFLJ1b8gMZy7" {
+		t.Error("seed 1 gave", vectors[0].Address)
+	}
+
+	if vectors := GenerateVectors(AddressType{EncodeType: "base58", Alphabet: BTCAlphabet, ChecksumType: "doubleSHA256"}, 1); vectors != nil {
+		t.Error("vectors of a type without hash length:", vectors)
+	}
+}
@@ -23,7 +23,11 @@ func decodeAE(pubKey string, addresstype AddressType) ([]byte, error) {
 		return nil, ErrorInvalidAddress
 	}
 
-	return ret[:len(ret)-4], nil
+	ret = ret[:len(ret)-checksumLength(addresstype.ChecksumType)]
+	if err := checkLength(len(ret), addresstype); err != nil {
+		return nil, err
+	}
+	return ret, nil
 }
 
 func encodeAE(hash []byte, addresstype AddressType) string {
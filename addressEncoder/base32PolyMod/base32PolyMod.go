@@ -2,12 +2,22 @@ package base32PolyMod
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 )
 
 var (
 	ErrorInvalidAddress = errors.New("Invalid address!")
 
+	// the causes Decode reports, all of them wrap ErrorInvalidAddress
+	ErrorInvalidCharacter = fmt.Errorf("%w Invalid character!", ErrorInvalidAddress)
+	ErrorChecksumMismatch = fmt.Errorf("%w Checksum mismatch!", ErrorInvalidAddress)
+	ErrorInvalidLength    = fmt.Errorf("%w Invalid payload length!", ErrorInvalidAddress)
+	ErrorSizeMismatch     = fmt.Errorf("%w Payload size does not match the version byte!", ErrorInvalidLength)
+
+	// hash size in bytes selected by the low 3 bits of the version byte
+	hashSizes = []int{20, 24, 28, 32, 40, 48, 56, 64}
+
 	charRev = []int8{
 		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
 		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
@@ -18,19 +28,18 @@ var (
 		-1, 29, -1, 24, 13, 25, 9, 8, 23, -1, 18, 22, 31, 27, 19, -1,
 		1, 0, 3, 16, 11, 28, 12, 14, 6, 4, 2, -1, -1, -1, -1, -1}
 )
-var (
-	bitcoincashExpandPrefix = []int8{2, 9, 20, 3, 15, 9, 14, 3, 1, 19, 8, 0}
-)
 
 func catBytes(data1 []int8, data2 []int8) []int8 {
 	return append(data1, data2...)
 }
 
+// expandPrefix gives the lower 5 bits of each prefix character followed by a zero separator
 func expandPrefix(prefix string) []int8 {
-	if prefix == "bitcoincash" {
-		return bitcoincashExpandPrefix
+	ret := make([]int8, len(prefix)+1)
+	for i := 0; i < len(prefix); i++ {
+		ret[i] = int8(prefix[i] & 0x1f)
 	}
-	return nil
+	return ret
 }
 
 func polyMod(V []int8) int64 {
@@ -97,17 +106,21 @@ func extendPayload(payload []int8) []int8 {
 	versionByte := type1 << 3
 	encodeedSize := int8(0)
 
-	switch (len(payload) - 1) * 8 {
-	case 160:
-		encodeedSize = 0
-		break
-	default:
-		break
+	for i, size := range hashSizes {
+		if size == len(payload)-1 {
+			encodeedSize = int8(i)
+			break
+		}
 	}
 
 	versionByte |= encodeedSize
 	payload[0] = versionByte
 
+	return packPayload(payload)
+}
+
+// packPayload splits payload into 5-bit groups, the last one padded with zero bits
+func packPayload(payload []int8) []int8 {
 	length := (len(payload)*8 + 4) / 5
 	ret := make([]int8, length)
 	i := 0
@@ -151,11 +164,21 @@ func Encode(prefix, alphabet string, payload []byte) string {
 	for i := 0; i < len(payload); i++ {
 		int8Payload[i] = int8(payload[i])
 	}
-	extendPayload := extendPayload(int8Payload)
-	checksum := make([]int8, 8)
-	if prefix == "bitcoincash" {
-		checksum = calcChecksum(bitcoincashExpandPrefix, extendPayload)
+	return encodeGroups(prefix, alphabet, extendPayload(int8Payload))
+}
+
+// EncodePayload works like Encode but takes payload as it is, its first byte is not turned into a cashaddr
+// version byte. Kaspa addresses are built this way.
+func EncodePayload(prefix, alphabet string, payload []byte) string {
+	int8Payload := make([]int8, len(payload))
+	for i := 0; i < len(payload); i++ {
+		int8Payload[i] = int8(payload[i])
 	}
+	return encodeGroups(prefix, alphabet, packPayload(int8Payload))
+}
+
+func encodeGroups(prefix, alphabet string, extendPayload []int8) string {
+	checksum := calcChecksum(expandPrefix(prefix), extendPayload)
 	combined := catBytes(extendPayload, checksum)
 	ret := prefix
 	ret += ":"
@@ -166,6 +189,24 @@ func Encode(prefix, alphabet string, payload []byte) string {
 }
 
 func Decode(address, alphabet string) ([]byte, error) {
+	bytePayload, err := DecodePayload(address, alphabet)
+	if err != nil {
+		return nil, err
+	}
+
+	//the version byte carries the hash size, which must agree with what was actually decoded
+	if bytePayload[0]&0x80 != 0 {
+		return nil, ErrorInvalidAddress
+	}
+	if hashSizes[bytePayload[0]&0x07] != len(bytePayload)-1 {
+		return nil, ErrorSizeMismatch
+	}
+	return bytePayload, nil
+}
+
+// DecodePayload works like Decode but gives the payload as it is, without checking its first byte
+// against the cashaddr version byte rules. It is the counterpart of EncodePayload.
+func DecodePayload(address, alphabet string) ([]byte, error) {
 	lower := false
 	upper := false
 	hasNumber := false
@@ -186,15 +227,19 @@ func Decode(address, alphabet string) ([]byte, error) {
 		}
 		if c == ':' {
 			if hasNumber || i == 0 || prefixSize != 0 {
-				return nil, ErrorInvalidAddress
+				return nil, ErrorInvalidCharacter
 			}
 			prefixSize = i
 			continue
 		}
-		return nil, ErrorInvalidAddress
+		return nil, ErrorInvalidCharacter
 	}
 
 	if upper && lower {
+		return nil, ErrorInvalidCharacter
+	}
+	//the prefix is part of the checksum, an address without it can not be verified
+	if prefixSize == 0 {
 		return nil, ErrorInvalidAddress
 	}
 
@@ -205,17 +250,19 @@ func Decode(address, alphabet string) ([]byte, error) {
 	for i := 0; i < valueSize; i++ {
 		c := address[i+prefixSize]
 		if c > 127 || charRev[c] == -1 {
-			return nil, ErrorInvalidAddress
+			return nil, ErrorInvalidCharacter
 		}
 		value[i] = charRev[c]
 	}
 
-	if prefixStr == "bitcoincash" {
-		if !verifyChecksum(bitcoincashExpandPrefix, value) {
-			return nil, ErrorInvalidAddress
-		}
+	//the checksum and at least one payload byte
+	if len(value) < 8+2 {
+		return nil, ErrorInvalidLength
 	}
 
+	if !verifyChecksum(expandPrefix(strings.ToLower(prefixStr)), value) {
+		return nil, ErrorChecksumMismatch
+	}
 	tmp := make([]int8, len(value)-8)
 	copy(tmp, value)
 
@@ -228,3 +275,14 @@ func Decode(address, alphabet string) ([]byte, error) {
 	}
 	return bytePayload, nil
 }
+
+// DecodeWithType works like Decode and splits the payload into the address type, the high 5 bits of the
+// version byte, and the hash. The type shows in the first character after the prefix: 'q' for type 0,
+// P2PKH, and 'p' for type 1, P2SH.
+func DecodeWithType(address, alphabet string) (byte, []byte, error) {
+	payload, err := Decode(address, alphabet)
+	if err != nil {
+		return 0, nil, err
+	}
+	return payload[0] >> 3, payload[1:], nil
+}
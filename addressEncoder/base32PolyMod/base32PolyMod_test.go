@@ -0,0 +1,131 @@
+package base32PolyMod
+
+import (
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+)
+
+const alphabet = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+// encodeRaw builds a cashaddr from payload as is, without touching its version byte
+func encodeRaw(payload []byte) string {
+	groups := []int8{}
+	acc, bits := 0, uint(0)
+	for _, b := range payload {
+		acc = acc<<8 | int(b)
+		bits += 8
+		for bits >= 5 {
+			bits -= 5
+			groups = append(groups, int8(acc>>bits&0x1f))
+		}
+	}
+	if bits > 0 {
+		groups = append(groups, int8(acc<<(5-bits)&0x1f))
+	}
+	checksum := calcChecksum(expandPrefix("bitcoincash"), groups)
+	ret := "bitcoincash:"
+	for _, b := range catBytes(groups, checksum) {
+		ret += alphabet[b : b+1]
+	}
+	return ret
+}
+
+func Test_versionByteSize(t *testing.T) {
+	hash, _ := hex.DecodeString("76a04053bda0a88bda5177b86a15c3b29f559873")
+
+	address := encodeRaw(append([]byte{0x00}, hash...))
+	if address != "bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a" {
+		t.Errorf("raw encode wrong result: %s", address)
+	}
+	if _, err := Decode(address, alphabet); err != nil {
+		t.Errorf("decode failed: %v", err)
+	}
+
+	// size bits claim 192 bits while the payload only carries 160
+	inconsistent := encodeRaw(append([]byte{0x01}, hash...))
+	if _, err := Decode(inconsistent, alphabet); err != ErrorSizeMismatch {
+		t.Errorf("size mismatch not detected, got: %v", err)
+	}
+
+	hash32, _ := hex.DecodeString("76a04053bda0a88bda5177b86a15c3b29f559873a04053bda0a88bda5177b86a")
+	ret, err := Decode(Encode("bitcoincash", alphabet, append([]byte{0x00}, hash32...)), alphabet)
+	if err != nil || ret[0] != 0x03 || hex.EncodeToString(ret[1:]) != hex.EncodeToString(hash32) {
+		t.Errorf("256 bits hash round trip failed: %v", err)
+	}
+}
+
+func Test_expandPrefix(t *testing.T) {
+	// checksum vectors from the cashaddr specification
+	for _, address := range []string{
+		"prefix:x64nx6hz",
+		"p:gpf8m4h7",
+		"bitcoincash:qpzry9x8gf2tvdw0s3jn54khce6mua7lcw20ayyn",
+		"bchreg:555555555555555555555555555555555555555555555udxmlmrz",
+		"bchtest:testnetaddress4d6njnut",
+	} {
+		pos := strings.IndexByte(address, ':')
+		value := make([]int8, len(address)-pos-1)
+		for i := range value {
+			value[i] = charRev[address[pos+1+i]]
+		}
+		if !verifyChecksum(expandPrefix(address[:pos]), value) {
+			t.Errorf("checksum of %s verify failed", address)
+		}
+	}
+
+	hash, _ := hex.DecodeString("76a04053bda0a88bda5177b86a15c3b29f559873")
+	address := Encode("bchtest", alphabet, append([]byte{0x00}, hash...))
+	if _, err := Decode(address, alphabet); err != nil {
+		t.Errorf("decode %s failed: %v", address, err)
+	}
+	if _, err := Decode("bitcoincash"+address[len("bchtest"):], alphabet); err == nil {
+		t.Error("prefix should be covered by the checksum")
+	}
+	if _, err := Decode(address[len("bchtest:"):], alphabet); err == nil {
+		t.Error("address without prefix can not be verified")
+	}
+}
+
+func Test_decodeErrors(t *testing.T) {
+	hash, _ := hex.DecodeString("76a04053bda0a88bda5177b86a15c3b29f559873")
+	vectors := []struct {
+		address string
+		err     error
+	}{
+		{"bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdxba", ErrorInvalidCharacter},
+		{"bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6A", ErrorInvalidCharacter},
+		{"bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6q", ErrorChecksumMismatch},
+		{"prefix:x64nx6hz", ErrorInvalidLength},
+		{encodeRaw(append([]byte{0x01}, hash...)), ErrorSizeMismatch},
+	}
+	for _, v := range vectors {
+		_, err := Decode(v.address, alphabet)
+		if !errors.Is(err, v.err) {
+			t.Errorf("%s: got %v, want %v", v.address, err, v.err)
+		}
+		if !errors.Is(err, ErrorInvalidAddress) {
+			t.Errorf("%s: %v should wrap ErrorInvalidAddress", v.address, err)
+		}
+	}
+	if !errors.Is(ErrorSizeMismatch, ErrorInvalidLength) {
+		t.Error("size mismatch is a length error")
+	}
+}
+
+func Test_DecodeWithType(t *testing.T) {
+	hash, _ := hex.DecodeString("76a04053bda0a88bda5177b86a15c3b29f559873")
+	for address, want := range map[string]byte{
+		"bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a": 0, // P2PKH
+		"bitcoincash:ppm2qsznhks23z7629mms6s4cwef74vcwvn0h829pq": 1, // P2SH
+	} {
+		addrType, ret, err := DecodeWithType(address, alphabet)
+		if err != nil || addrType != want || hex.EncodeToString(ret) != hex.EncodeToString(hash) {
+			t.Errorf("%s: got type %d, %x, %v", address, addrType, ret, err)
+		}
+	}
+	if _, _, err := DecodeWithType("bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6q", alphabet); err != ErrorChecksumMismatch {
+		t.Errorf("broken checksum: %v", err)
+	}
+}
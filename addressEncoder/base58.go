@@ -3,6 +3,7 @@ package addressEncoder
 import (
 	"errors"
 	"fmt"
+	"math/big"
 )
 
 // Errors
@@ -32,7 +33,8 @@ func NewBase58Alphabet(alphabet string) *Base58Alphabet {
 	ret.unicodeDecodeTable = make([]rune, 0, 58*2)
 	for idx, ch := range alphabetRunes {
 		ret.encodeTable[idx] = ch
-		if ch >= 0 && ch < 256 {
+		// only ASCII goes to the byte table, a byte of 128 and above is part of a multibyte rune in a string
+		if ch >= 0 && ch < 128 {
 			ret.decodeTable[byte(ch)] = idx
 		} else {
 			ret.unicodeDecodeTable = append(ret.unicodeDecodeTable, ch)
@@ -42,6 +44,16 @@ func NewBase58Alphabet(alphabet string) *Base58Alphabet {
 	return ret
 }
 
+// DecodeMap returns a copy of the byte to value mapping of the Alphabet, -1 for bytes not in it.
+// Characters of a unicode Alphabet outside the ASCII range are not part of the map.
+func (alphabet *Base58Alphabet) DecodeMap() [256]int8 {
+	var ret [256]int8
+	for i, v := range alphabet.decodeTable {
+		ret[i] = int8(v)
+	}
+	return ret
+}
+
 // Encode encode with custom Alphabet
 func Base58Encode(input []byte, alphabet *Base58Alphabet) string {
 	// Prefix 0
@@ -91,6 +103,10 @@ func Base58Encode(input []byte, alphabet *Base58Alphabet) string {
 
 // Decode docode with custom Alphabet
 func Base58Decode(input string, alphabet *Base58Alphabet) ([]byte, error) {
+	// when not contains unicode, use []byte to improve performance
+	if len(alphabet.unicodeDecodeTable) == 0 {
+		return Base58DecodeBytes([]byte(input), alphabet)
+	}
 	inputBytes := []rune(input)
 	inputLength := len(inputBytes)
 	capacity := inputLength*733/1000 + 1 // log(58) / log(256)
@@ -107,7 +123,7 @@ func Base58Decode(input string, alphabet *Base58Alphabet) ([]byte, error) {
 	for inputPos := 0; inputPos < inputLength; inputPos++ {
 		carry := -1
 		target := inputBytes[inputPos]
-		if target >= 0 && target < 256 {
+		if target >= 0 && target < 128 {
 			carry = alphabet.decodeTable[target]
 		} else { // unicode
 			for i := 0; i < len(alphabet.unicodeDecodeTable); i += 2 {
@@ -136,3 +152,53 @@ func Base58Decode(input string, alphabet *Base58Alphabet) ([]byte, error) {
 	}
 	return retBytes, nil
 }
+
+// Base58DecodeBytes decode input given as a byte slice with custom Alphabet.
+// Input is taken byte by byte, so the bytes of a multibyte character, all 128 and above, are rejected.
+func Base58DecodeBytes(input []byte, alphabet *Base58Alphabet) ([]byte, error) {
+	if len(alphabet.unicodeDecodeTable) != 0 {
+		return Base58Decode(string(input), alphabet)
+	}
+	inputLength := len(input)
+	capacity := inputLength*733/1000 + 1 // log(58) / log(256)
+	output := make([]byte, capacity)
+	outputReverseEnd := capacity - 1
+
+	// Prefix 0
+	zero58Byte := byte(alphabet.encodeTable[0])
+	prefixZeroes := 0
+	for prefixZeroes < inputLength && input[prefixZeroes] == zero58Byte {
+		prefixZeroes++
+	}
+
+	for inputPos := 0; inputPos < inputLength; inputPos++ {
+		carry := alphabet.decodeTable[input[inputPos]]
+		if carry == -1 {
+			return nil, ErrorInvalidBase58String
+		}
+
+		outputIdx := capacity - 1
+		for ; carry != 0 || outputIdx > outputReverseEnd; outputIdx-- {
+			carry += 58 * int(output[outputIdx])
+			output[outputIdx] = byte(uint32(carry) & 0xff) // same as: byte(uint32(carry) % 256)
+			carry >>= 8                                    // same as: carry /= 256
+		}
+		outputReverseEnd = outputIdx
+	}
+
+	retBytes := make([]byte, prefixZeroes+(capacity-1-outputReverseEnd))
+	for i, n := range output[outputReverseEnd+1:] {
+		retBytes[prefixZeroes+i] = n
+	}
+	return retBytes, nil
+}
+
+// Base58DecodeBigInt returns the value of input as a base58 number with custom Alphabet. Unlike the byte
+// decode, leading zero characters are not turned into zero bytes, they add nothing to the value.
+func Base58DecodeBigInt(input string, alphabet *Base58Alphabet) (*big.Int, error) {
+	ret, err := Base58Decode(input, alphabet)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(ret), nil
+}
@@ -0,0 +1,49 @@
+//go:build differential
+// +build differential
+
+// Differential tests against btcutil, run with: go test -tags differential ./...
+
+package addressEncoder
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+
+	"github.com/btcsuite/btcutil/base58"
+)
+
+func Test_differential_base58(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	alphabet := NewBase58Alphabet(BTCAlphabet)
+	for i := 0; i < 10000; i++ {
+		input := make([]byte, r.Intn(64))
+		r.Read(input)
+		// leading zeroes are where base58 implementations usually disagree
+		for j := 0; j < len(input) && r.Intn(2) == 0; j++ {
+			input[j] = 0
+		}
+
+		encoded := Base58Encode(input, alphabet)
+		if expect := base58.Encode(input); encoded != expect {
+			t.Errorf("encode mismatch on %x: %s != %s", input, encoded, expect)
+		}
+
+		candidate := []byte(encoded)
+		if len(candidate) > 0 && i%2 == 1 {
+			candidate[r.Intn(len(candidate))] = byte(r.Intn(128))
+		}
+		decoded, err := Base58Decode(string(candidate), alphabet)
+		expect := base58.Decode(string(candidate))
+		if err != nil {
+			// btcutil reports an invalid string with an empty result
+			if len(expect) != 0 {
+				t.Errorf("decode mismatch on %q: %v", candidate, err)
+			}
+			continue
+		}
+		if !bytes.Equal(decoded, expect) {
+			t.Errorf("decode mismatch on %q: %x != %x", candidate, decoded, expect)
+		}
+	}
+}
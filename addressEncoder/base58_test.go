@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"github.com/blocktree/go-owcdrivers/addressEncoder/bech32"
+	"strings"
 	"testing"
 )
 
@@ -25,4 +26,84 @@ func TestBech32(t *testing.T) {
 
 	addr := bech32.Encode(prefix, BTCBech32Alphabet, hash, nil)
 	fmt.Println(addr)
-}
\ No newline at end of file
+}
+
+func TestBase58DecodeMap(t *testing.T) {
+	alphabet := NewBase58Alphabet(BTCAlphabet)
+	m := alphabet.DecodeMap()
+	for i := 0; i < 256; i++ {
+		expect := int8(strings.IndexByte(BTCAlphabet, byte(i)))
+		if m[i] != expect {
+			t.Errorf("value of %q is %d, want %d", byte(i), m[i], expect)
+		}
+	}
+	for _, c := range "0OIl" {
+		if m[c] != -1 {
+			t.Errorf("%q should not be in the alphabet", c)
+		}
+	}
+
+	// the map is a copy
+	m['1'] = 5
+	if alphabet.DecodeMap()['1'] != 0 {
+		t.Error("alphabet changed through its decode map")
+	}
+}
+
+func Test_base58_multibyte(t *testing.T) {
+	alphabet := NewBase58Alphabet(BTCAlphabet)
+	address := "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"
+	for _, input := range []string{
+		address + "\u200b",                        // a trailing zero-width space
+		address + "\u00e9",                        // a two byte character
+		"1\u0412vBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", // a cyrillic homoglyph of B
+		address + "\xff",                          // not even utf-8
+	} {
+		if _, err := Base58Decode(input, alphabet); err != ErrorInvalidBase58String {
+			t.Errorf("%q: got %v", input, err)
+		}
+		if _, err := Base58DecodeBytes([]byte(input), alphabet); err != ErrorInvalidBase58String {
+			t.Errorf("%q from bytes: got %v", input, err)
+		}
+		if _, err := AddressDecode(input, BTC_mainnetAddressP2PKH); err == nil {
+			t.Errorf("%q decoded as an address", input)
+		}
+	}
+
+	// a character of 128 to 255 in an alphabet is matched as a rune, not as a raw byte
+	latin := NewBase58Alphabet(BTCAlphabet[:57] + "é")
+	data := []byte{0x39}
+	encoded := Base58Encode(data, latin)
+	if !strings.HasSuffix(encoded, "é") {
+		t.Fatalf("encoded %q, want it to end in é", encoded)
+	}
+	if ret, err := Base58Decode(encoded, latin); err != nil || hex.EncodeToString(ret) != "39" {
+		t.Errorf("decode %q: %x, %v", encoded, ret, err)
+	}
+	if _, err := Base58Decode(encoded[:len(encoded)-2]+"\xe9", latin); err != ErrorInvalidBase58String {
+		t.Errorf("raw latin-1 byte: got %v", err)
+	}
+}
+
+func Test_Base58DecodeBigInt(t *testing.T) {
+	alphabet := NewBase58Alphabet(BTCAlphabet)
+	for input, want := range map[string]string{
+		"":                                   "0",
+		"1":                                  "0",
+		"111":                                "0",
+		"2":                                  "1",
+		"z":                                  "57",
+		"21":                                 "58",
+		"11z":                                "57",
+		"zz":                                 "3363",
+		"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2": "2936256236368367529205845895214681571805604556586060445291",
+	} {
+		ret, err := Base58DecodeBigInt(input, alphabet)
+		if err != nil || ret.String() != want {
+			t.Errorf("%q: got %v, %v, want %s", input, ret, err, want)
+		}
+	}
+	if _, err := Base58DecodeBigInt("10", alphabet); err != ErrorInvalidBase58String {
+		t.Error("invalid character:", err)
+	}
+}
@@ -1,13 +1,37 @@
 package bech32
 
 import (
-	"encoding/hex"
 	"errors"
 	"strings"
 )
 
+const (
+	// VariantBech32 is the checksum defined by BIP-173
+	VariantBech32 = "bech32"
+	// VariantBech32m is the modified checksum defined by BIP-350
+	VariantBech32m = "bech32m"
+
+	// maxLength is the longest string BIP-173 allows
+	maxLength = 90
+
+	// charset is the alphabet of BIP-173
+	charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+	// witness program kinds
+	KindP2WPKH = "p2wpkh"
+	KindP2WSH  = "p2wsh"
+	KindP2TR   = "p2tr"
+	// KindWitnessUnknown is a program of a witness version or length with no meaning defined yet
+	KindWitnessUnknown = "unknown"
+
+	bech32Const  = uint32(1)
+	bech32mConst = uint32(0x2bc830a3)
+)
+
 var (
-	ErrorInvalidAddress = errors.New("Invalid address!")
+	ErrorInvalidAddress  = errors.New("Invalid address!")
+	ErrorInvalidBitGroup = errors.New("Invalid bit group size!")
+	ErrorInvalidHRP      = errors.New("Invalid human readable part!")
 	/*
 	 This table corresponding to the first 128 chars in ascii table.If the char is not one of
 	 "qpzry9x8gf2tvdw0s3jn54khce6mua7l" which is the code table of base32(Only consists
@@ -42,7 +66,28 @@ func expandPrefix(prefix string) []int8 {
 	return ret
 }
 
+// bech32Generators are the bech32 constants {2^n}k(x) for n = 0..4, where k(x) = x^6 mod g(x) and g(x)
+// is the generator of BIP-173. bech32m uses the same ones and only differs by the final constant.
+var bech32Generators = [5]uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+
+// Generators returns a copy of the bech32 generators, to pass to Polymod.
+func Generators() [5]uint32 {
+	return bech32Generators
+}
+
 func polyMod(v []int8) uint32 {
+	values := make([]int, len(v))
+	for i := range v {
+		values[i] = int(v[i])
+	}
+	return Polymod(values, bech32Generators) ^ 1
+}
+
+// Polymod computes the BCH checksum polymod of BIP-173 over the 5-bit values with the given
+// generators, for any code over GF(32) with a 6 character checksum. A string is valid when the
+// result over its expanded human readable part, data and checksum is the variant constant,
+// 1 for bech32 and 0x2bc830a3 for bech32m.
+func Polymod(values []int, generators [5]uint32) uint32 {
 	/*
 	   The input is interpreted as a list of coefficients of a polynomial over F = GF(32),
 	   with an implicit 1 in front. If the input is [v0,v1,v2,v3,v4], that polynomial is
@@ -70,7 +115,7 @@ func polyMod(v []int8) uint32 {
 	   for `c`.
 	*/
 	c := uint32(1)
-	for _, v_i := range v {
+	for _, v_i := range values {
 		/*
 		   g(x):=x^6 + {29}x^5 + {22}x^4 + {20}x^3 + {21}x^2 + {29}x + 18
 		   We want to update `c` to correspond to a polynomial with one extra term. If the initial
@@ -101,7 +146,7 @@ func polyMod(v []int8) uint32 {
 			    fill with 0 in the front.That is 0011(3),1011(b),0110(6),1010(a),0101(5),0111(7),1011(b),0010(2),
 			    equal to hex:0x3b6a57b2
 			*/
-			c ^= 0x3b6a57b2
+			c ^= generators[0]
 		}
 		/*
 		    {2}k(x) = {2}*{29}x^5 + {2}*{22}x^4 + {2}*{20}x^3 + {2}*{21}x^2 +{2}*{29}x + {2}*{18}
@@ -136,7 +181,7 @@ func polyMod(v []int8) uint32 {
 		    equal to hex:0x26508e6d
 		*/
 		if c0&2 != 0 {
-			c ^= 0x26508e6d
+			c ^= generators[1]
 		}
 		/*
 		    {4}k(x)={4}*{29}x^5 + {4}*{22}x^4 + {4}*{20}x^3 + {4}*{21}x^2 +{4}*{29}x + {4}*{18}
@@ -172,7 +217,7 @@ func polyMod(v []int8) uint32 {
 		    equal to hex:0x1ea119fa
 		*/
 		if c0&4 != 0 {
-			c ^= 0x1ea119fa
+			c ^= generators[2]
 		}
 		/*
 		    {8}k(x)={8}*{29}x^5 + {8}*{22}x^4 + {8}*{20}x^3 + {8}*{21}x^2 +{8}*{29}x + {8}*{18}
@@ -208,7 +253,7 @@ func polyMod(v []int8) uint32 {
 		    equal to hex:0x3d4233dd
 		*/
 		if c0&8 != 0 {
-			c ^= 0x3d4233dd
+			c ^= generators[3]
 		}
 		/*
 		    {16}k(x)={16}*{29}x^5 + {16}*{22}x^4 + {16}*{20}x^3 + {16}*{21}x^2 +{16}*{29}x + {16}*{18}
@@ -244,10 +289,10 @@ func polyMod(v []int8) uint32 {
 		    equal to hex:0x2a1462b3
 		*/
 		if c0&16 != 0 {
-			c ^= 0x2a1462b3
+			c ^= generators[4]
 		}
 	}
-	return c ^ 1
+	return c
 }
 
 func lowerCase(c byte) byte {
@@ -257,11 +302,42 @@ func lowerCase(c byte) byte {
 	return c
 }
 
-func verifyChecksum(prefix string, data []int8) bool {
-	return polyMod(catBytes(expandPrefix(prefix), data)) == 0
+// foldCase returns address in lower case, or ErrorInvalidAddress if it mixes upper and lower case letters.
+// Only the ASCII letters are folded: strings.ToLower turns some other runes, such as the kelvin sign,
+// into ASCII letters of the charset, which would let those through.
+func foldCase(address string) (string, error) {
+	var hasLower, hasUpper bool
+	lower := make([]byte, len(address))
+	for i := 0; i < len(address); i++ {
+		c := address[i]
+		hasLower = hasLower || (c >= 'a' && c <= 'z')
+		hasUpper = hasUpper || (c >= 'A' && c <= 'Z')
+		lower[i] = lowerCase(c)
+	}
+	if hasLower && hasUpper {
+		return "", ErrorInvalidAddress
+	}
+	return string(lower), nil
+}
+
+// checksumVariant returns the variant whose constant matches the checksum of data,
+// or an empty string if the checksum is invalid under both.
+func checksumVariant(prefix string, data []int8) string {
+	c := polyMod(catBytes(expandPrefix(prefix), data)) ^ 1
+	if c == bech32Const {
+		return VariantBech32
+	}
+	if c == bech32mConst {
+		return VariantBech32m
+	}
+	return ""
 }
 
 func calcChecksum(prefix string, data []int8) []int8 {
+	return calcChecksumWithVariant(prefix, data, VariantBech32)
+}
+
+func calcChecksumWithVariant(prefix string, data []int8, variant string) []int8 {
 	enc := catBytes(expandPrefix(prefix), data)
 	ret := [6]int8{}
 	tmp := make([]int8, len(enc)+6)
@@ -269,6 +345,9 @@ func calcChecksum(prefix string, data []int8) []int8 {
 	copy(tmp, enc)
 
 	mod := polyMod(tmp)
+	if variant == VariantBech32m {
+		mod ^= bech32Const ^ bech32mConst
+	}
 
 	for i := 0; i < 6; i++ {
 		ret[i] = int8((mod >> (5 * (5 - uint(i)))) & 0x1f)
@@ -312,27 +391,37 @@ func extendPayload(payload []int8) []int8 {
 	return ret
 }
 
-func unecxtendPayload(extendedPayload []int8) []int8 {
-	length := len(extendedPayload) * 5 / 8
-
-	ret := make([]int8, length)
+func Encode(prefix, alphabet string, payload []byte, payloadPrefix []byte) string {
+	return EncodeWithVariant(prefix, alphabet, payload, payloadPrefix, VariantBech32)
+}
 
-	for i := 0; i < len(extendedPayload)-1; i++ {
-		ret[length-1] |= extendedPayload[i]
-		byteShl5(&ret)
+// checkHRP returns ErrorInvalidHRP unless prefix is a non-empty human readable part of the ASCII
+// characters 33 to 126 allowed by BIP-173
+func checkHRP(prefix string) error {
+	if len(prefix) == 0 {
+		return ErrorInvalidHRP
 	}
-	ret[length-1] |= extendedPayload[len(extendedPayload)-1]
-
-	return ret
+	for i := 0; i < len(prefix); i++ {
+		if prefix[i] < 33 || prefix[i] > 126 {
+			return ErrorInvalidHRP
+		}
+	}
+	return nil
 }
 
-func Encode(prefix, alphabet string, payload []byte, payloadPrefix []byte) string {
+// EncodeWithVariant works like Encode but computes the checksum of the given variant. An empty string is
+// returned if prefix is no valid human readable part or holds upper case letters, which would mix with
+// the lower case data part.
+func EncodeWithVariant(prefix, alphabet string, payload []byte, payloadPrefix []byte, variant string) string {
+	if checkHRP(prefix) != nil || strings.ToLower(prefix) != prefix {
+		return ""
+	}
 	int8Payload := make([]int8, len(payload))
 	for i := 0; i < len(payload); i++ {
 		int8Payload[i] = int8(payload[i])
 	}
 	extendPayload := extendPayload(int8Payload)
-	if payloadPrefix != nil {
+	if len(payloadPrefix) > 0 {
 		predata := []int8{}
 		for _, data := range payloadPrefix {
 			predata = append(predata, int8(data))
@@ -340,7 +429,7 @@ func Encode(prefix, alphabet string, payload []byte, payloadPrefix []byte) strin
 		extendPayload = append(predata, extendPayload...)
 	}
 
-	checksum := calcChecksum(prefix, extendPayload)
+	checksum := calcChecksumWithVariant(prefix, extendPayload, variant)
 	combined := catBytes(extendPayload, checksum)
 
 	ret := prefix + "1"
@@ -350,70 +439,220 @@ func Encode(prefix, alphabet string, payload []byte, payloadPrefix []byte) strin
 	return ret
 }
 
+// Decode decodes a bech32 string with the BIP-173 checksum. The separator is the last "1", so the human
+// readable part may be a single character or contain a "1" itself.
 func Decode(address, alphabet string) ([]byte, error) {
-	lower := false
-	upper := false
-	hasNumber := false
-	prefixSize := 0
-	for i := 0; i < len(address); i++ {
-		c := address[i]
-		if c >= 'a' && c <= 'z' {
-			lower = true
-			continue
+	_, data, variant, err := decodeData(address, alphabet)
+	if err == ErrorInvalidHRP {
+		return nil, err
+	}
+	if err != nil || variant != VariantBech32 {
+		return nil, ErrorInvalidAddress
+	}
+
+	//the groups make up the payload alone, or follow a witness version group; either way
+	//the bits padding the payload to whole 5-bit groups must be zero
+	bytePayload, err := ConvertBits(data, 5, 8, false)
+	if err != nil || len(bytePayload) == 33 {
+		_, bytePayload, err = splitVersion(data)
+		if err != nil {
+			return nil, ErrorInvalidAddress
 		}
-		if c >= 'A' && c <= 'Z' {
-			upper = true
-			continue
+	}
+	return bytePayload, nil
+
+}
+
+// ConvertBits regroups data from fromBits-bit groups into toBits-bit groups, the convertbits of BIP-173.
+// Both group sizes are between 1 and 8 bits. When pad is true, the last group is filled up with
+// zero bits; otherwise the leftover bits must be fewer than fromBits and all zero.
+func ConvertBits(data []byte, fromBits, toBits uint, pad bool) ([]byte, error) {
+	if fromBits < 1 || fromBits > 8 || toBits < 1 || toBits > 8 {
+		return nil, ErrorInvalidBitGroup
+	}
+	acc := uint32(0)
+	bits := uint(0)
+	maxv := uint32(1)<<toBits - 1
+	ret := make([]byte, 0, len(data)*int(fromBits)/int(toBits)+1)
+	for _, value := range data {
+		if uint32(value)>>fromBits != 0 {
+			return nil, ErrorInvalidAddress
 		}
-		if c == '0' || (c >= '2' && c <= '9') {
-			hasNumber = true
-			continue
+		acc = acc<<fromBits | uint32(value)
+		bits += fromBits
+		for bits >= toBits {
+			bits -= toBits
+			ret = append(ret, byte(acc>>bits&maxv))
 		}
-		if c == '1' {
-			if hasNumber || i == 0 || prefixSize != 0 {
-				return nil, ErrorInvalidAddress
-			}
-			prefixSize = i
-			continue
+	}
+	if pad {
+		if bits > 0 {
+			ret = append(ret, byte(acc<<(toBits-bits)&maxv))
 		}
+	} else if bits >= fromBits || acc<<(toBits-bits)&maxv != 0 {
 		return nil, ErrorInvalidAddress
 	}
+	return ret, nil
+}
 
-	if upper && lower {
-		return nil, ErrorInvalidAddress
+// decodeData checks the characters and the checksum of address, and returns the human readable part,
+// the data part without checksum as 5-bit groups and the checksum variant.
+func decodeData(address, alphabet string) (string, []byte, string, error) {
+	return decodeDataWithLimit(address, alphabet, maxLength)
+}
+
+// decodeDataWithLimit works like decodeData with limit as the longest address accepted, 0 for no limit
+func decodeDataWithLimit(address, alphabet string, limit int) (string, []byte, string, error) {
+	if limit > 0 && len(address) > limit {
+		return "", nil, "", ErrorInvalidAddress
+	}
+	//the separator is the last 1, the human readable part is of any length and may hold digits, 1 included
+	pos := strings.LastIndexByte(address, '1')
+	if pos < 1 || pos+7 > len(address) {
+		return "", nil, "", ErrorInvalidAddress
 	}
+	//checked before changing case, which replaces bytes above 126 that are no utf-8
+	if err := checkHRP(address[:pos]); err != nil {
+		return "", nil, "", err
+	}
+	lower, err := foldCase(address)
+	if err != nil {
+		return "", nil, "", err
+	}
+	prefix := lower[:pos]
 
-	prefixStr := strings.Split(address, "1")[0]
-	prefixSize++
-	valueSize := len(address) - prefixSize
-	value := make([]int8, valueSize)
-	for i := 0; i < valueSize; i++ {
-		c := address[i+prefixSize]
-		if c > 127 || CHARSET_REV[c] == -1 {
-			return nil, ErrorInvalidAddress
+	value := make([]int8, len(lower)-pos-1)
+	for i := range value {
+		index := strings.IndexByte(alphabet, lower[pos+1+i])
+		if index == -1 {
+			return "", nil, "", ErrorInvalidAddress
 		}
-		value[i] = CHARSET_REV[c]
+		value[i] = int8(index)
 	}
 
-	if !verifyChecksum(prefixStr, value) {
-		return nil, ErrorInvalidAddress
+	variant := checksumVariant(prefix, value)
+	if variant == "" {
+		return "", nil, "", ErrorInvalidAddress
+	}
+
+	data := make([]byte, len(value)-6)
+	for i := range data {
+		data[i] = byte(value[i])
+	}
+	return prefix, data, variant, nil
+}
+
+// DecodeRaw checks address against the BIP-173 charset and either checksum variant, and returns the
+// human readable part in lower case and the data part without checksum as 5-bit groups, unconverted.
+func DecodeRaw(address string) (string, []byte, error) {
+	hrp, groups, _, err := decodeData(address, charset)
+	if err != nil {
+		return "", nil, err
+	}
+	return hrp, groups, nil
+}
+
+// DecodeRawNoLimit works like DecodeRaw without the 90 character limit of BIP-173, for formats using
+// the bech32 checksum over longer strings, such as the cardano addresses.
+func DecodeRawNoLimit(address string) (string, []byte, error) {
+	hrp, groups, _, err := decodeDataWithLimit(address, charset, 0)
+	if err != nil {
+		return "", nil, err
 	}
+	return hrp, groups, nil
+}
 
-	tmp := make([]int8, len(value)-6)
-	copy(tmp, value)
+// DecodeRawVariant works like DecodeRawNoLimit and returns the checksum variant as well, for formats
+// that only allow one of them.
+func DecodeRawVariant(address string) (string, []byte, string, error) {
+	return decodeDataWithLimit(address, charset, 0)
+}
 
-	ret := unecxtendPayload(tmp)
+// DecodeWithVersion decodes a segwit address, returns the witness version, the witness program
+// and the checksum variant it was encoded with.
+func DecodeWithVersion(address, alphabet string) (int, []byte, string, error) {
+	_, data, variant, err := decodeData(address, alphabet)
+	if err != nil {
+		return 0, nil, "", err
+	}
+	version, program, err := splitVersion(data)
+	if err != nil {
+		return 0, nil, "", err
+	}
+	if len(program) < 2 || len(program) > 40 {
+		return 0, nil, "", ErrorInvalidAddress
+	}
+	//BIP-141 only defines 20 and 32 byte programs for version 0
+	if version == 0 && len(program) != 20 && len(program) != 32 {
+		return 0, nil, "", ErrorInvalidAddress
+	}
+	return version, program, variant, nil
+}
 
-	bytePayload := make([]byte, len(ret))
+// WitnessKind returns the kind of a witness program: KindP2WPKH for the 20 bytes and KindP2WSH for
+// the 32 bytes of version 0, KindP2TR for the 32 bytes of version 1 and KindWitnessUnknown for the
+// rest. Per BIP-350 version 0 must be checksummed as bech32 and later versions as bech32m.
+func WitnessKind(version int, program []byte, variant string) (string, error) {
+	if version < 0 || version > 16 || len(program) < 2 || len(program) > 40 {
+		return "", ErrorInvalidAddress
+	}
+	if version == 0 {
+		if variant != VariantBech32 {
+			return "", ErrorInvalidAddress
+		}
+		switch len(program) {
+		case 20:
+			return KindP2WPKH, nil
+		case 32:
+			return KindP2WSH, nil
+		}
+		return "", ErrorInvalidAddress
+	}
+	if variant != VariantBech32m {
+		return "", ErrorInvalidAddress
+	}
+	if version == 1 && len(program) == 32 {
+		return KindP2TR, nil
+	}
+	return KindWitnessUnknown, nil
+}
 
-	for i := 0; i < len(ret); i++ {
-		bytePayload[i] = byte(ret[i])
+// DecodeSegwit works like DecodeWithVersion but returns the kind of the witness program instead of
+// the checksum variant, see WitnessKind.
+func DecodeSegwit(address, alphabet string) (int, []byte, string, error) {
+	version, program, variant, err := DecodeWithVersion(address, alphabet)
+	if err != nil {
+		return 0, nil, "", err
+	}
+	kind, err := WitnessKind(version, program, variant)
+	if err != nil {
+		return 0, nil, "", err
 	}
+	return version, program, kind, nil
+}
 
-	if len(bytePayload) == 33 {
-		tmp := hex.EncodeToString(bytePayload)[1:65]
-		bytePayload, _ = hex.DecodeString(tmp)
+func splitVersion(data []byte) (int, []byte, error) {
+	if len(data) < 1 || data[0] > 16 {
+		return 0, nil, ErrorInvalidAddress
 	}
-	return bytePayload, nil
+	program, err := ConvertBits(data[1:], 5, 8, false)
+	if err != nil {
+		return 0, nil, err
+	}
+	return int(data[0]), program, nil
+}
 
+// DecodeLong works like DecodeWithVersion for addresses some chains allow beyond the limits of BIP-173,
+// such as the litecoin MWEB ones: no 90 character limit and a program of any length.
+// The human readable part is returned as well, in lower case.
+func DecodeLong(address, alphabet string) (string, int, []byte, string, error) {
+	prefix, data, variant, err := decodeDataWithLimit(address, alphabet, 0)
+	if err != nil {
+		return "", 0, nil, "", err
+	}
+	version, program, err := splitVersion(data)
+	if err != nil {
+		return "", 0, nil, "", err
+	}
+	return prefix, version, program, variant, nil
 }
@@ -0,0 +1,82 @@
+//go:build differential
+// +build differential
+
+// Differential tests against btcutil, run with: go test -tags differential ./...
+
+package bech32
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+
+	btcbech32 "github.com/btcsuite/btcutil/bech32"
+)
+
+const testAlphabet = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+func randomHRP(r *rand.Rand) string {
+	hrp := make([]byte, 1+r.Intn(10))
+	for i := range hrp {
+		hrp[i] = byte('a' + r.Intn(26))
+	}
+	return string(hrp)
+}
+
+func mutate(r *rand.Rand, address string) string {
+	b := []byte(address)
+	switch r.Intn(5) {
+	case 0:
+		b[r.Intn(len(b))] = testAlphabet[r.Intn(32)]
+	case 1:
+		b = b[:r.Intn(len(b))]
+	case 2:
+		b = bytes.ToUpper(b)
+	case 3:
+		b[r.Intn(len(b))] = byte(r.Intn(256))
+	case 4:
+		b = append(b, testAlphabet[r.Intn(32)])
+	}
+	return string(b)
+}
+
+func Test_differential_bech32(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 10000; i++ {
+		hrp := randomHRP(r)
+		program := make([]byte, []int{20, 32}[r.Intn(2)])
+		r.Read(program)
+
+		address := EncodeWithVariant(hrp, testAlphabet, program, []byte{0}, VariantBech32)
+
+		data, err := btcbech32.ConvertBits(program, 8, 5, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expect, err := btcbech32.Encode(hrp, append([]byte{0}, data...))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if address != expect {
+			t.Errorf("encode mismatch: %s != %s", address, expect)
+		}
+
+		candidate := address
+		if i%2 == 1 {
+			candidate = mutate(r, address)
+		}
+		gotHRP, gotData, variant, gotErr := decodeData(candidate, testAlphabet)
+		expHRP, expData, expErr := btcbech32.Decode(candidate)
+		if gotErr == nil && variant == VariantBech32m {
+			// btcutil only knows about the BIP-173 checksum
+			continue
+		}
+		if (gotErr == nil) != (expErr == nil) {
+			t.Errorf("decode mismatch on %q: %v != %v", candidate, gotErr, expErr)
+			continue
+		}
+		if gotErr == nil && (gotHRP != expHRP || !bytes.Equal(gotData, expData)) {
+			t.Errorf("decode mismatch on %q", candidate)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package bech32
 import (
 	"encoding/hex"
 	"fmt"
+	"strings"
 	"testing"
 )
 
@@ -15,7 +16,7 @@ func Test_bech32_address(t *testing.T) {
 		fmt.Println(hex.EncodeToString(ret))
 	}
 
-	addresschk := Encode("bc", "qpzry9x8gf2tvdw0s3jn54khce6mua7l", ret, nil)
+	addresschk := Encode("bc", "qpzry9x8gf2tvdw0s3jn54khce6mua7l", ret, []byte{0})
 	if addresschk != address {
 		t.Error("encode error")
 	} else {
@@ -23,3 +24,348 @@ func Test_bech32_address(t *testing.T) {
 	}
 
 }
+
+func Test_bech32m_address(t *testing.T) {
+	address := "bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj0"
+	version, program, variant, err := DecodeWithVersion(address, "qpzry9x8gf2tvdw0s3jn54khce6mua7l")
+	if err != nil {
+		t.Error("decode error")
+		return
+	}
+	if version != 1 || variant != VariantBech32m || hex.EncodeToString(program) != "79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798" {
+		t.Error("decode wrong result")
+	}
+
+	addresschk := EncodeWithVariant("bc", "qpzry9x8gf2tvdw0s3jn54khce6mua7l", program, []byte{1}, VariantBech32m)
+	if addresschk != address {
+		t.Error("encode error")
+	}
+
+	// same data part checksummed as bech32
+	_, _, variant, err = DecodeWithVersion("bc1qvgclzqz7smqr6haag9mknpwsjnxtdqkncr64kd", "qpzry9x8gf2tvdw0s3jn54khce6mua7l")
+	if err != nil || variant != VariantBech32 {
+		t.Error("bech32 variant not detected")
+	}
+}
+
+func Test_bech32_max_length(t *testing.T) {
+	// valid checksum but 91 characters long, BIP-173 limits addresses to 90
+	address := "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa1jsd6e5"
+	if _, _, _, err := decodeData(address, "qpzry9x8gf2tvdw0s3jn54khce6mua7l"); err == nil {
+		t.Error("over long address should be rejected")
+	}
+}
+
+func Test_bech32_long(t *testing.T) {
+	address := "ltcmweb1qqfumuen7l8wthtz45p3ftn58pvrs9xlumvkuu2xet8egzkcklqtesqkxq3legs0d04knq32qd62uqlxct3mcujuvau7202avpxu4cuy7u5l7dx6j"
+	if _, _, _, err := DecodeWithVersion(address, "qpzry9x8gf2tvdw0s3jn54khce6mua7l"); err == nil {
+		t.Error("BIP-173 decode should reject a 121 character address")
+	}
+	hrp, version, program, variant, err := DecodeLong(address, "qpzry9x8gf2tvdw0s3jn54khce6mua7l")
+	if err != nil || hrp != "ltcmweb" || version != 0 || len(program) != 66 || variant != VariantBech32 {
+		t.Errorf("long decode failed: %s %d %x %s %v", hrp, version, program, variant, err)
+	}
+}
+
+func Test_ConvertBits(t *testing.T) {
+	const charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+	program, _ := hex.DecodeString("751e76e8199196d454941c45d1b3a323f1433bd6")
+
+	// 8 to 5 gives the data part of bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4 after the version
+	data, err := ConvertBits(program, 8, 5, true)
+	if err != nil {
+		t.Error(err)
+	}
+	encoded := ""
+	for _, d := range data {
+		encoded += charset[d : d+1]
+	}
+	if encoded != "w508d6qejxtdg4y5r3zarvary0c5xw7k" {
+		t.Error("8 to 5 wrong result:", encoded)
+	}
+	ret, err := ConvertBits(data, 5, 8, false)
+	if err != nil || hex.EncodeToString(ret) != hex.EncodeToString(program) {
+		t.Error("5 to 8 wrong result:", hex.EncodeToString(ret), err)
+	}
+
+	tests := []struct {
+		data     []byte
+		from, to uint
+		pad      bool
+		want     string
+		err      bool
+	}{
+		{[]byte{0xff}, 8, 5, true, "1f1c", false},
+		{[]byte{0xff}, 8, 5, false, "", true},      // 3 bits left over
+		{[]byte{31, 28}, 5, 8, false, "ff", false}, // 2 zero padding bits
+		{[]byte{31, 29}, 5, 8, false, "", true},    // padding bits not zero
+		{[]byte{31, 28}, 5, 8, true, "ff00", false},
+		{[]byte{31, 28, 0}, 5, 8, false, "", true}, // a whole group of padding
+		{[]byte{32}, 5, 8, true, "", true},         // not a 5-bit value
+		{[]byte{}, 8, 5, false, "", false},
+		{[]byte{1}, 0, 5, true, "", true},
+		{[]byte{1}, 8, 9, true, "", true},
+	}
+	for i, test := range tests {
+		ret, err := ConvertBits(test.data, test.from, test.to, test.pad)
+		if test.err {
+			if err == nil {
+				t.Errorf("case %d: expected an error, got %x", i, ret)
+			}
+			continue
+		}
+		if err != nil || hex.EncodeToString(ret) != test.want {
+			t.Errorf("case %d: got %x, %v, want %s", i, ret, err, test.want)
+		}
+	}
+}
+
+func Test_bech32_nonzero_padding(t *testing.T) {
+	const charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+	ret, err := Decode("bc1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3qccfmv3", charset)
+	if err != nil || hex.EncodeToString(ret) != "1863143c14c5166804bd19203356da136c985678cd4d27a1b8c6329604903262" {
+		t.Error("decode failed:", hex.EncodeToString(ret), err)
+	}
+	ret, err = Decode("cosmos1qqqsyqcyq5rqwzqfpg9scrgwpugpzysnzs23v9ccrydpk8qarc0sxaggsw", charset)
+	if err != nil || hex.EncodeToString(ret) != "000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f" {
+		t.Error("decode without version failed:", hex.EncodeToString(ret), err)
+	}
+
+	// the same payloads with a padding bit set in the last group, with valid checksums
+	for _, address := range []string{
+		"bc1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3p9waw3r",
+		"cosmos1qqqsyqcyq5rqwzqfpg9scrgwpugpzysnzs23v9ccrydpk8qarc0ccfvywn",
+	} {
+		if _, err := Decode(address, charset); err == nil {
+			t.Error("non-zero padding accepted:", address)
+		}
+		if _, _, _, err := DecodeWithVersion(address, charset); err == nil {
+			t.Error("non-zero padding accepted with version:", address)
+		}
+	}
+}
+
+func Test_DecodeRaw(t *testing.T) {
+	program, _ := hex.DecodeString("751e76e8199196d454941c45d1b3a323f1433bd6")
+	groups, _ := ConvertBits(program, 8, 5, true)
+	expect := append([]byte{0}, groups...)
+
+	for _, address := range []string{"bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4", "BC1QW508D6QEJXTDG4Y5R3ZARVARY0C5XW7KV8F3T4"} {
+		hrp, ret, err := DecodeRaw(address)
+		if err != nil || hrp != "bc" || hex.EncodeToString(ret) != hex.EncodeToString(expect) {
+			t.Errorf("%s: got %s %x %v, want bc %x", address, hrp, ret, err, expect)
+		}
+	}
+
+	// the BIP-173 vector with an empty data part
+	hrp, ret, err := DecodeRaw("a12uel5l")
+	if err != nil || hrp != "a" || len(ret) != 0 {
+		t.Errorf("empty data part: got %s %x %v", hrp, ret, err)
+	}
+
+	if _, _, err := DecodeRaw("bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t5"); err == nil {
+		t.Error("bad checksum accepted")
+	}
+}
+
+func Test_bech32_short_hrp(t *testing.T) {
+	const charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+	tests := map[string]string{
+		"a12uel5l":         "", // the shortest string, no data at all
+		"a1q3g6mn3":        "", // one data character
+		"A1QPZRY9X856RV98": "00443214c7",
+		"abcdef1qpzry9x8gf2tvdw0s3jn54khce6mua7lmqqqxw": "00443214c74254b635cf84653a56d7c675be77df",
+		// the separator is the last 1, the human readable part contains one
+		"an83characterlonghumanreadablepartthatcontainsthenumber1andtheexcludedcharactersbio1tt5tgs": "",
+	}
+	for address, expect := range tests {
+		ret, err := Decode(address, charset)
+		if err != nil || hex.EncodeToString(ret) != expect {
+			t.Errorf("%s: got %x, %v, want %s", address, ret, err, expect)
+		}
+	}
+
+	for _, address := range []string{
+		"a1",       // no checksum
+		"a12uel5",  // checksum too short
+		"12uel5l",  // no human readable part
+		"a12uel5m", // checksum mismatch
+		"a1q3g6mN3",
+	} {
+		if ret, err := Decode(address, charset); err == nil {
+			t.Errorf("%s decoded as %x", address, ret)
+		}
+	}
+}
+
+func Test_Polymod(t *testing.T) {
+	const charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+	values := func(address string) []int {
+		pos := strings.LastIndexByte(address, '1')
+		var ret []int
+		for _, v := range expandPrefix(address[:pos]) {
+			ret = append(ret, int(v))
+		}
+		for i := pos + 1; i < len(address); i++ {
+			ret = append(ret, strings.IndexByte(charset, address[i]))
+		}
+		return ret
+	}
+
+	// BIP-173 and BIP-350 vectors
+	for _, address := range []string{"a12uel5l", "abcdef1qpzry9x8gf2tvdw0s3jn54khce6mua7lmqqqxw", "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4"} {
+		if c := Polymod(values(address), Generators()); c != 1 {
+			t.Errorf("%s: polymod %x, want the bech32 constant 1", address, c)
+		}
+	}
+	for _, address := range []string{"a1lqfn3a", "abcdef1l7aum6echk45nj3s0wdvt2fg8x9yrzpqzd3ryx", "bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj0"} {
+		if c := Polymod(values(address), Generators()); c != 0x2bc830a3 {
+			t.Errorf("%s: polymod %x, want the bech32m constant 0x2bc830a3", address, c)
+		}
+	}
+
+	// the checksum characters are the ones that bring the polymod to the constant: 6 zero values
+	// in their place give the constant xor the checksum
+	v := values("a12uel5l")
+	checksum := Polymod(append(v[:len(v)-6:len(v)-6], 0, 0, 0, 0, 0, 0), Generators()) ^ 1
+	encoded := ""
+	for i := 0; i < 6; i++ {
+		encoded += string(charset[checksum>>uint(5*(5-i))&31])
+	}
+	if encoded != "2uel5l" {
+		t.Error("checksum computed through Polymod:", encoded)
+	}
+
+	// the generators handed out are a copy, changing them leaves the checksums alone
+	g := Generators()
+	g[0] = 0
+	if c := Polymod(values("a12uel5l"), Generators()); c != 1 {
+		t.Errorf("generators changed through a copy: polymod %x", c)
+	}
+
+	// other generators give another code
+	if c := Polymod(values("a12uel5l"), [5]uint32{1, 2, 4, 8, 16}); c == 1 {
+		t.Error("checksum valid under other generators")
+	}
+}
+
+func Test_DecodeSegwit(t *testing.T) {
+	const charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+	tests := []struct {
+		address string
+		version int
+		size    int
+		kind    string
+	}{
+		{"bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4", 0, 20, KindP2WPKH},
+		{"bc1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3qccfmv3", 0, 32, KindP2WSH},
+		{"bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj0", 1, 32, KindP2TR},
+		{"BC1SW50QGDZ25J", 16, 2, KindWitnessUnknown},
+	}
+	for _, test := range tests {
+		version, program, kind, err := DecodeSegwit(test.address, charset)
+		if err != nil || version != test.version || len(program) != test.size || kind != test.kind {
+			t.Errorf("%s: got %d %x %s %v", test.address, version, program, kind, err)
+		}
+	}
+
+	for _, address := range []string{
+		"BC1QR508D6QEJXTDG4Y5R3ZARVARYV98GJ9P",                                       // version 0 with a 16-byte program
+		"bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kemeawh",                                 // version 0 with a bech32m checksum
+		"bc1zw508d6qejxtdg4y5r3zarvaryvqyzf3du",                                      // version 2 with a bech32 checksum
+		"bc1pw508d6qejxtdg4y5r3zarvary0c5xw7kw508d6qejxtdg4y5r3zarvary0c5xw7k7grplx", // bech32 checksum
+	} {
+		if _, _, kind, err := DecodeSegwit(address, charset); err == nil {
+			t.Errorf("%s accepted as %s", address, kind)
+		}
+	}
+	if _, _, _, err := DecodeWithVersion("BC1QR508D6QEJXTDG4Y5R3ZARVARYV98GJ9P", charset); err == nil {
+		t.Error("16-byte version 0 program accepted")
+	}
+}
+
+func Test_bech32_lightning_hrp(t *testing.T) {
+	// the BOLT-11 donation invoice, 243 characters with the human readable part lnbc
+	invoice := "lnbc1pvjluezpp5qqqsyqcyq5rqwzqfqqqsyqcyq5rqwzqfqqqsyqcyq5rqwzqfqypqdpl2pkx2ctnv5sxxmmwwd5kgetjypeh2ursdae8g6twvus8g6rfwvs8qun0dfjkxaq8rkx3yf5tcsyz3d73gafnh3cax9rn449d9p5uxz9ezhhypd0elx87sjle52x86fux2ypatgddc6k63n7erqz25le42c4u4ecky03ylcqca784w"
+	hrp, groups, err := DecodeRawNoLimit(invoice)
+	if err != nil || hrp != "lnbc" || len(groups) != 232 {
+		t.Fatalf("got %s, %d groups, %v", hrp, len(groups), err)
+	}
+	// the data starts with the 7 groups of the timestamp
+	timestamp := ""
+	for _, g := range groups[:7] {
+		timestamp += charset[g : g+1]
+	}
+	if timestamp != "pvjluez" {
+		t.Error("timestamp groups:", timestamp)
+	}
+	if _, _, err := DecodeRaw(invoice); err == nil {
+		t.Error("243 characters accepted under the BIP-173 limit")
+	}
+
+	// an amount in the human readable part puts digits before the separator
+	for address, expect := range map[string]string{
+		"lnbc2500u1pzry9x8gf2la3zg7": "lnbc2500u",
+		"LNTB20M1PZRY9X8GF2R4D7LS":   "lntb20m",
+	} {
+		hrp, groups, err := DecodeRaw(address)
+		if err != nil || hrp != expect || hex.EncodeToString(groups) != "0102030405060708090a" {
+			t.Errorf("%s: got %s %x, %v", address, hrp, groups, err)
+		}
+	}
+}
+
+func Test_bech32_hrp_characters(t *testing.T) {
+	// BIP-173 invalid vectors with a space, DEL and a byte above 126 as the human readable part,
+	// and a control character
+	for _, address := range []string{"\x201nwldj5", "\x7f1axkwrx", "\x801eym55h", "a\x01b1qqqqqqqq"} {
+		if _, err := Decode(address, charset); err != ErrorInvalidHRP {
+			t.Errorf("%q: got %v, want ErrorInvalidHRP", address, err)
+		}
+		if _, _, err := DecodeRaw(address); err != ErrorInvalidHRP {
+			t.Errorf("%q: raw decode got %v, want ErrorInvalidHRP", address, err)
+		}
+	}
+
+	for _, hrp := range []string{"", "b c", "b\x01c", "b\x7f", "BC"} {
+		if ret := Encode(hrp, charset, make([]byte, 20), []byte{0}); ret != "" {
+			t.Errorf("%q: encoded %s", hrp, ret)
+		}
+	}
+	if ret := Encode("!~", charset, make([]byte, 20), []byte{0}); ret == "" {
+		t.Error("human readable part of the first and last allowed characters not encoded")
+	}
+}
+
+func Test_bech32_case(t *testing.T) {
+	const charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+	const program = "751e76e8199196d454941c45d1b3a323f1433bd6"
+
+	for _, address := range []string{
+		"bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4",
+		"BC1QW508D6QEJXTDG4Y5R3ZARVARY0C5XW7KV8F3T4",
+	} {
+		ret, err := Decode(address, charset)
+		if err != nil || hex.EncodeToString(ret) != program {
+			t.Errorf("%s: got %x, %v", address, ret, err)
+		}
+		if hrp, _, err := DecodeRaw(address); err != nil || hrp != "bc" {
+			t.Errorf("%s: human readable part %s, %v", address, hrp, err)
+		}
+	}
+
+	for _, address := range []string{
+		"bc1qW508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4",
+		"Bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4",
+		// the kelvin sign, which strings.ToLower turns into k
+		"BC1QW508D6QEJXTDG4Y5R3ZARVARY0C5XW7\u212aV8F3T4",
+		"bc1qw508d6qejxtdg4y5r3zarvary0c5xw7\u212av8f3t4",
+	} {
+		if _, err := Decode(address, charset); err == nil {
+			t.Errorf("%s accepted", address)
+		}
+	}
+}
@@ -0,0 +1,223 @@
+package addressEncoder
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+
+	"github.com/blocktree/go-owcdrivers/addressEncoder/bech32"
+	"github.com/blocktree/go-owcrypt"
+)
+
+// bitcoin address kinds
+const (
+	BitcoinP2PKH  = "p2pkh"
+	BitcoinP2SH   = "p2sh"
+	BitcoinP2WPKH = "p2wpkh"
+	BitcoinP2WSH  = "p2wsh"
+	BitcoinP2TR   = "p2tr"
+	// BitcoinWitnessUnknown is a witness program of a version or length with no meaning yet
+	BitcoinWitnessUnknown = "witness_unknown"
+	// BitcoinP2PK is an output paying to a bare public key, which has no address form but the hex of the key
+	BitcoinP2PK = "p2pk"
+)
+
+var (
+	ErrorNetworkType   = errors.New("Invalid network type!")
+	ErrorNotPubKeyHash = errors.New("Address is not a public key hash!")
+	ErrorInvalidPubKey = errors.New("Invalid public key!")
+	// ErrorUncompressedPubKey is returned for a segwit output of an uncompressed key, which is not standard and can not be spent
+	ErrorUncompressedPubKey = errors.New("Segwit requires a compressed public key!")
+	// ErrorNotP2WPKHType is returned when an address type other than a version 0 bech32 hash160 one is asked for a P2WPKH address
+	ErrorNotP2WPKHType = errors.New("Address type is not a P2WPKH one!")
+)
+
+// isScriptHashType reports whether the 20-byte payload of addresstype is the hash of a script,
+// as marked by ScriptHash, cashaddr types are told from their Prefix
+func isScriptHashType(addresstype AddressType) bool {
+	if addresstype.EncodeType == "base32PolyMod" {
+		// the one byte Prefix is the cashaddr type, P2SH or its token aware form
+		return len(addresstype.Prefix) == 1 && (addresstype.Prefix[0] == CashAddrP2SH || addresstype.Prefix[0] == CashAddrTokenP2SH)
+	}
+	return addresstype.ScriptHash
+}
+
+// BitcoinParams holds the address types of one bitcoin network
+type BitcoinParams struct {
+	P2PKH AddressType
+	P2SH  AddressType
+	HRP   string //segwit地址的人类可读部分
+}
+
+var (
+	BTC_mainnetParams = BitcoinParams{BTC_mainnetAddressP2PKH, BTC_mainnetAddressP2SH, "bc"}
+	BTC_testnetParams = BitcoinParams{BTC_testnetAddressP2PKH, BTC_testnetAddressP2SH, "tb"}
+)
+
+var bitcoinNetworks = map[string]BitcoinParams{
+	"mainnet": BTC_mainnetParams,
+	"testnet": BTC_testnetParams,
+}
+
+// BitcoinDecoded is a decoded bitcoin address
+type BitcoinDecoded struct {
+	Kind    string // BitcoinP2PKH, BitcoinP2SH, BitcoinP2WPKH, BitcoinP2WSH, BitcoinP2TR or BitcoinWitnessUnknown
+	Version int    // witness version, 0 for P2PKH and P2SH
+	Hash    []byte // the public key hash, script hash or witness program
+}
+
+// isPublicKey reports whether key has the length and leading byte of a compressed or uncompressed secp256k1 public key
+func isPublicKey(key []byte) bool {
+	switch len(key) {
+	case 33:
+		return key[0] == 0x02 || key[0] == 0x03
+	case 65:
+		return key[0] == 0x04
+	}
+	return false
+}
+
+// IsPublicKeyHex reports whether s is the hex of a compressed (66 characters) or uncompressed
+// (130 characters) public key, the pseudo-address of a P2PK output. It is never a hash based address.
+func IsPublicKeyHex(s string) bool {
+	if len(s) != 66 && len(s) != 130 {
+		return false
+	}
+	key, err := hex.DecodeString(s)
+	return err == nil && isPublicKey(key)
+}
+
+// FormatP2PK returns the pseudo-address of an output paying to pubkey, its lower case hex.
+func FormatP2PK(pubkey []byte) (string, error) {
+	if !isPublicKey(pubkey) {
+		return "", ErrorInvalidPubKey
+	}
+	return hex.EncodeToString(pubkey), nil
+}
+
+// pubKeyForms returns pubkey in both its compressed and uncompressed form, pubkey first
+func pubKeyForms(pubkey []byte) [][]byte {
+	if len(pubkey) == 33 {
+		return [][]byte{pubkey, owcrypt.PointDecompress(pubkey, owcrypt.ECC_CURVE_SECP256K1)}
+	}
+	return [][]byte{pubkey, owcrypt.PointCompress(pubkey[1:], owcrypt.ECC_CURVE_SECP256K1)}
+}
+
+// EncodeP2WPKH returns the P2WPKH address of pubkey for addresstype, a version 0 bech32 preset such as
+// BTC_mainnetAddressBech32V0, any other address type gives ErrorNotP2WPKHType. The witness program must be
+// the hash of a compressed key, so an uncompressed pubkey gives ErrorUncompressedPubKey, unless compress is
+// set and it is compressed first.
+func EncodeP2WPKH(pubkey []byte, addresstype AddressType, compress bool) (string, error) {
+	if addresstype.EncodeType != "bech32" || !bytes.Equal(addresstype.Prefix, []byte{0}) ||
+		addresstype.HashType != "h160" || addresstype.HashLen != 20 {
+		return "", ErrorNotP2WPKHType
+	}
+	if !isPublicKey(pubkey) {
+		return "", ErrorInvalidPubKey
+	}
+	if len(pubkey) == 65 {
+		if !compress {
+			return "", ErrorUncompressedPubKey
+		}
+		pubkey = owcrypt.PointCompress(pubkey[1:], owcrypt.ECC_CURVE_SECP256K1)
+	}
+	return GenerateAddress(pubkey, addresstype)
+}
+
+// AddressMatchesPubKey reports whether address of addresstype pays to pubkey, as when checking the key recovered
+// from a signed message against the claimed address. The hash of either form of the key may match, so a
+// compressed key matches the address of its uncompressed form and the other way around. An error is only
+// returned for an invalid key or an address that does not decode.
+func AddressMatchesPubKey(address string, pubkey []byte, addresstype AddressType) (bool, error) {
+	if !isPublicKey(pubkey) {
+		return false, ErrorInvalidPubKey
+	}
+	data, err := AddressDecode(address, addresstype)
+	if err != nil {
+		return false, err
+	}
+	for _, key := range pubKeyForms(pubkey) {
+		hash := calcHash(key, addresstype.HashType)
+		if hash == nil {
+			hash = key
+		} else {
+			hash = truncateHash(hash, addresstype)
+		}
+		if bytes.Equal(hash, data) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// BitcoinDecode decodes a legacy or segwit bitcoin address of the network params stands for
+// and tells which kind of hash or witness program it carries.
+func BitcoinDecode(address string, params BitcoinParams) (*BitcoinDecoded, error) {
+	if bech32HRPMatches(address, params.HRP) {
+		version, program, kind, err := bech32.DecodeSegwit(address, BTCBech32Alphabet)
+		if err != nil {
+			return nil, ErrorInvalidAddress
+		}
+		ret := &BitcoinDecoded{Kind: BitcoinWitnessUnknown, Version: version, Hash: program}
+		switch kind {
+		case bech32.KindP2WPKH:
+			ret.Kind = BitcoinP2WPKH
+		case bech32.KindP2WSH:
+			ret.Kind = BitcoinP2WSH
+		case bech32.KindP2TR:
+			ret.Kind = BitcoinP2TR
+		}
+		return ret, nil
+	}
+
+	if hash, err := AddressDecode(address, params.P2PKH); err == nil {
+		return &BitcoinDecoded{Kind: BitcoinP2PKH, Hash: hash}, nil
+	}
+	if hash, err := AddressDecode(address, params.P2SH); err == nil {
+		return &BitcoinDecoded{Kind: BitcoinP2SH, Hash: hash}, nil
+	}
+	return nil, ErrorInvalidAddress
+}
+
+// ClassifyBitcoinAddress returns the kind of a bitcoin address on network ("mainnet" or "testnet"),
+// one of BitcoinP2PKH, BitcoinP2SH, BitcoinP2WPKH, BitcoinP2WSH and BitcoinP2TR, or BitcoinP2PK for
+// the hex of a public key, which is the same on every network.
+func ClassifyBitcoinAddress(address string, network string) (string, error) {
+	params, ok := bitcoinNetworks[network]
+	if !ok {
+		return "", ErrorNetworkType
+	}
+
+	if IsPublicKeyHex(address) {
+		return BitcoinP2PK, nil
+	}
+
+	decoded, err := BitcoinDecode(address, params)
+	if err != nil || decoded.Kind == BitcoinWitnessUnknown {
+		return "", ErrorInvalidAddress
+	}
+	return decoded.Kind, nil
+}
+
+// PubKeyHash returns the 20-byte public key hash(hash160) carried by a P2PKH style or a P2WPKH address.
+// Script hash addresses, including P2SH wrapped segwit ones which only commit to the redeem script,
+// and addresses not built on hash160 return ErrorNotPubKeyHash.
+func PubKeyHash(address string, addresstype AddressType) ([]byte, error) {
+	hash, err := AddressDecode(address, addresstype)
+	if err != nil {
+		return nil, err
+	}
+	if len(hash) != 20 {
+		return nil, ErrorNotPubKeyHash
+	}
+	if addresstype.EncodeType == "bech32" && len(addresstype.Prefix) > 0 {
+		if addresstype.Prefix[0] != 0 {
+			return nil, ErrorNotPubKeyHash
+		}
+		return hash, nil
+	}
+	if addresstype.HashType != "h160" || isScriptHashType(addresstype) {
+		return nil, ErrorNotPubKeyHash
+	}
+	return hash, nil
+}
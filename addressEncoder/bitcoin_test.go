@@ -0,0 +1,220 @@
+package addressEncoder
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func Test_ClassifyBitcoinAddress(t *testing.T) {
+	vectors := map[string]string{
+		"19xD3nnvEiu7Uqd8irRvF3j5ExLb4ZtSju":                             BitcoinP2PKH,
+		"3BYx8ciMdywxd2bbn5h9V7EAZtzLg2RhhX":                             BitcoinP2SH,
+		"bc1qvgclzqz7smqr6haag9mknpwsjnxtdqkncr64kd":                     BitcoinP2WPKH,
+		"bc1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3qccfmv3": BitcoinP2WSH,
+		"bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj0": BitcoinP2TR,
+	}
+	for address, want := range vectors {
+		kind, err := ClassifyBitcoinAddress(address, "mainnet")
+		if err != nil {
+			t.Errorf("classify %s failed: %v", address, err)
+			continue
+		}
+		if kind != want {
+			t.Errorf("classify %s: got %s, want %s", address, kind, want)
+		}
+	}
+
+	kind, err := ClassifyBitcoinAddress("tb1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vq47zagq", "testnet")
+	if err != nil || kind != BitcoinP2TR {
+		t.Errorf("classify testnet p2tr failed: %s, %v", kind, err)
+	}
+
+	if _, err := ClassifyBitcoinAddress("tb1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vq47zagq", "mainnet"); err == nil {
+		t.Error("testnet address should not classify on mainnet")
+	}
+	if _, err := ClassifyBitcoinAddress("19xD3nnvEiu7Uqd8irRvF3j5ExLb4ZtSju", "regtest"); err != ErrorNetworkType {
+		t.Errorf("unknown network not rejected: %v", err)
+	}
+}
+
+func Test_BitcoinDecode(t *testing.T) {
+	vectors := []struct {
+		address string
+		kind    string
+		version int
+		hash    string
+	}{
+		{"1BpEi6DfDAUFd7GtittLSdBeYJvcoaVggu", BitcoinP2PKH, 0, "76a04053bda0a88bda5177b86a15c3b29f559873"},
+		{"3CWFddi6m4ndiGyKqzYvsFYagqDLPVMTzC", BitcoinP2SH, 0, "76a04053bda0a88bda5177b86a15c3b29f559873"},
+		{"bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4", BitcoinP2WPKH, 0, "751e76e8199196d454941c45d1b3a323f1433bd6"},
+		{"bc1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3qccfmv3", BitcoinP2WSH, 0, "1863143c14c5166804bd19203356da136c985678cd4d27a1b8c6329604903262"},
+		{"bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj0", BitcoinP2TR, 1, "79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"},
+		{"BC1SW50QGDZ25J", BitcoinWitnessUnknown, 16, "751e"},
+	}
+	for _, v := range vectors {
+		ret, err := BitcoinDecode(v.address, BTC_mainnetParams)
+		if err != nil {
+			t.Errorf("decode %s failed: %v", v.address, err)
+			continue
+		}
+		if ret.Kind != v.kind || ret.Version != v.version || hex.EncodeToString(ret.Hash) != v.hash {
+			t.Errorf("decode %s: got %s %d %x", v.address, ret.Kind, ret.Version, ret.Hash)
+		}
+	}
+
+	if _, err := BitcoinDecode("bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4", BTC_testnetParams); err != ErrorInvalidAddress {
+		t.Errorf("mainnet address decoded on testnet: %v", err)
+	}
+	if _, err := ClassifyBitcoinAddress("BC1SW50QGDZ25J", "mainnet"); err != ErrorInvalidAddress {
+		t.Errorf("unknown witness program classified: %v", err)
+	}
+	// the human readable part is bc1x, which only starts with the bc1 of mainnet
+	if _, err := BitcoinDecode("bc1x1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqpp3snm", BTC_mainnetParams); err != ErrorInvalidAddress {
+		t.Errorf("bc1x address decoded on mainnet: %v", err)
+	}
+	if _, err := ClassifyBitcoinAddress("bc1x1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqpp3snm", "mainnet"); err != ErrorInvalidAddress {
+		t.Errorf("bc1x address classified on mainnet: %v", err)
+	}
+}
+
+func Test_PubKeyHash(t *testing.T) {
+	want := "6231f1005e86c03d5fbd41776985d094ccb682d3"
+
+	hash, err := PubKeyHash("19xD3nnvEiu7Uqd8irRvF3j5ExLb4ZtSju", BTC_mainnetAddressP2PKH)
+	if err != nil || hex.EncodeToString(hash) != want {
+		t.Errorf("p2pkh pubkey hash failed: %x, %v", hash, err)
+	}
+
+	hash, err = PubKeyHash("bc1qvgclzqz7smqr6haag9mknpwsjnxtdqkncr64kd", BTC_mainnetAddressBech32V0)
+	if err != nil || hex.EncodeToString(hash) != want {
+		t.Errorf("p2wpkh pubkey hash failed: %x, %v", hash, err)
+	}
+
+	if _, err = PubKeyHash("3BYx8ciMdywxd2bbn5h9V7EAZtzLg2RhhX", BTC_mainnetAddressP2SH); err != ErrorNotPubKeyHash {
+		t.Errorf("p2sh should not give a pubkey hash: %v", err)
+	}
+	if _, err = PubKeyHash("bc1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3qccfmv3", BTC_mainnetAddressP2WSH); err != ErrorNotPubKeyHash {
+		t.Errorf("p2wsh should not give a pubkey hash: %v", err)
+	}
+	if _, err = PubKeyHash("bc1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3qccfmv3", BTC_mainnetAddressBech32V0); err != ErrorNotPubKeyHash {
+		t.Errorf("p2wsh should not give a pubkey hash with the bech32 v0 preset: %v", err)
+	}
+	// the 0x3A version byte of a qtum P2PKH is a litecoin testnet P2SH one
+	if hash, err = PubKeyHash("QQfTuAKdRrTawjiPZRcQ6iaK9BgxwMDgXN", QTUM_mainnetAddressP2PKH); err != nil || hex.EncodeToString(hash) != "2c88f3163a4a308dd024080d6f822a23d47f3229" {
+		t.Errorf("qtum p2pkh pubkey hash failed: %x, %v", hash, err)
+	}
+	if _, err = PubKeyHash("QQfTuAKdRrTawjiPZRcQ6iaK9BgxwMDgXN", LTC_testnetAddressP2SH2); err != ErrorNotPubKeyHash {
+		t.Errorf("litecoin testnet p2sh should not give a pubkey hash: %v", err)
+	}
+	if hash, err = PubKeyHash("bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a", BCH_mainnetAddressCashP2PKH); err != nil || hex.EncodeToString(hash) != "76a04053bda0a88bda5177b86a15c3b29f559873" {
+		t.Errorf("cashaddr p2pkh pubkey hash failed: %x, %v", hash, err)
+	}
+	for address, addresstype := range map[string]AddressType{
+		"bitcoincash:ppm2qsznhks23z7629mms6s4cwef74vcwvn0h829pq": BCH_mainnetAddressCashP2SH,
+		"bitcoincash:rpm2qsznhks23z7629mms6s4cwef74vcwv59yeyr7n": BCH_mainnetAddressCashTokenP2SH,
+		"bchtest:rpm2qsznhks23z7629mms6s4cwef74vcwvshq7x5e0":     BCH_testnetAddressCashTokenP2SH,
+	} {
+		if _, err = PubKeyHash(address, addresstype); err != ErrorNotPubKeyHash {
+			t.Errorf("%s should not give a pubkey hash: %v", address, err)
+		}
+	}
+	if _, err = PubKeyHash("0x50068fd632c1a6e6c5bd407b4ccf8861a589e776", ETH_mainnetPublicAddress); err != ErrorNotPubKeyHash {
+		t.Errorf("eth address should not give a pubkey hash: %v", err)
+	}
+}
+
+func Test_P2PK(t *testing.T) {
+	// the public key of the secret key 1
+	compressed := "0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"
+	uncompressed := "0479be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b8"
+
+	for _, s := range []string{compressed, uncompressed, "03" + compressed[2:]} {
+		if !IsPublicKeyHex(s) {
+			t.Errorf("%s not taken as a public key", s)
+		}
+		kind, err := ClassifyBitcoinAddress(s, "mainnet")
+		if err != nil || kind != BitcoinP2PK {
+			t.Errorf("classify %s: got %s, %v", s, kind, err)
+		}
+		key, _ := hex.DecodeString(s)
+		if ret, err := FormatP2PK(key); err != nil || ret != s {
+			t.Errorf("format %s: got %s, %v", s, ret, err)
+		}
+	}
+
+	for _, s := range []string{
+		"04" + compressed[2:],   // uncompressed marker on 33 bytes
+		"02" + uncompressed[2:], // compressed marker on 65 bytes
+		compressed[:64],         // too short
+		"0279BE667EF9DCBBAC55A06295CE870B07029BFCDB2DCE28D959F2815B16F8179z",
+		"751e76e8199196d454941c45d1b3a323f1433bd6", // a hash, not a key
+		"19xD3nnvEiu7Uqd8irRvF3j5ExLb4ZtSju",
+	} {
+		if IsPublicKeyHex(s) {
+			t.Errorf("%s taken as a public key", s)
+		}
+	}
+
+	if _, err := FormatP2PK(make([]byte, 33)); err != ErrorInvalidPubKey {
+		t.Error("zero key formatted:", err)
+	}
+}
+
+func Test_AddressMatchesPubKey(t *testing.T) {
+	compressed, _ := hex.DecodeString("0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798")
+	uncompressed, _ := hex.DecodeString("0479be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b8")
+	other, _ := hex.DecodeString("02c6047f9441ed7d6d3045406e95c07cd85c778e4b8cef3ca7abac09b95c709ee5")
+	tests := []struct {
+		address     string
+		pubkey      []byte
+		addresstype AddressType
+		match       bool
+	}{
+		{"1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH", compressed, BTC_mainnetAddressP2PKH, true},
+		{"1EHNa6Q4Jz2uvNExL497mE43ikXhwF6kZm", uncompressed, BTC_mainnetAddressP2PKH, true},
+		// the other form of the key
+		{"1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH", uncompressed, BTC_mainnetAddressP2PKH, true},
+		{"1EHNa6Q4Jz2uvNExL497mE43ikXhwF6kZm", compressed, BTC_mainnetAddressP2PKH, true},
+		{"bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4", compressed, BTC_mainnetAddressBech32V0, true},
+		// another key
+		{"1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH", other, BTC_mainnetAddressP2PKH, false},
+		{"bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4", other, BTC_mainnetAddressBech32V0, false},
+	}
+	for _, test := range tests {
+		match, err := AddressMatchesPubKey(test.address, test.pubkey, test.addresstype)
+		if err != nil || match != test.match {
+			t.Errorf("%s with %x: got %v, %v, want %v", test.address, test.pubkey, match, err, test.match)
+		}
+	}
+
+	if _, err := AddressMatchesPubKey("1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH", compressed[1:], BTC_mainnetAddressP2PKH); err != ErrorInvalidPubKey {
+		t.Error("invalid key:", err)
+	}
+	if _, err := AddressMatchesPubKey("1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMJ", compressed, BTC_mainnetAddressP2PKH); err != ErrorInvalidAddress {
+		t.Error("invalid address:", err)
+	}
+}
+
+func Test_EncodeP2WPKH(t *testing.T) {
+	compressed, _ := hex.DecodeString("0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798")
+	uncompressed, _ := hex.DecodeString("0479be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b8")
+	want := "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4"
+
+	if ret, err := EncodeP2WPKH(compressed, BTC_mainnetAddressBech32V0, false); err != nil || ret != want {
+		t.Errorf("compressed key: got %s, %v, want %s", ret, err, want)
+	}
+	if ret, err := EncodeP2WPKH(uncompressed, BTC_mainnetAddressBech32V0, false); err != ErrorUncompressedPubKey {
+		t.Errorf("uncompressed key accepted: %s, %v", ret, err)
+	}
+	if ret, err := EncodeP2WPKH(uncompressed, BTC_mainnetAddressBech32V0, true); err != nil || ret != want {
+		t.Errorf("uncompressed key with compress: got %s, %v, want %s", ret, err, want)
+	}
+	if _, err := EncodeP2WPKH(compressed[1:], BTC_mainnetAddressBech32V0, true); err != ErrorInvalidPubKey {
+		t.Error("invalid key:", err)
+	}
+	for _, addresstype := range []AddressType{BTC_mainnetAddressP2PKH, BTC_mainnetAddressTaproot, BTC_mainnetAddressP2WSH, ATOM_mainnetAddress} {
+		if ret, err := EncodeP2WPKH(compressed, addresstype, false); err != ErrorNotP2WPKHType {
+			t.Errorf("%+v: got %s, %v", addresstype, ret, err)
+		}
+	}
+}
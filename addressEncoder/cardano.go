@@ -0,0 +1,57 @@
+package addressEncoder
+
+import (
+	"errors"
+
+	"github.com/blocktree/go-owcdrivers/addressEncoder/bech32"
+)
+
+var (
+	ErrorNotBaseAddress = errors.New("Not a cardano base address!")
+)
+
+// cardanoStakeHRP maps the human readable part of cardano payment addresses to the one of reward addresses
+var cardanoStakeHRP = map[string]string{
+	"addr":      "stake",
+	"addr_test": "stake_test",
+}
+
+// cardanoBaseAddress splits a cardano base address, header(1) || payment credential(28) || stake credential(28),
+// and returns its human readable part, header byte and stake credential.
+func cardanoBaseAddress(address string) (string, byte, []byte, error) {
+	hrp, groups, err := bech32.DecodeRawNoLimit(address)
+	if err != nil {
+		return "", 0, nil, ErrorInvalidAddress
+	}
+	if _, ok := cardanoStakeHRP[hrp]; !ok {
+		return "", 0, nil, ErrorInvalidAddress
+	}
+	data, err := bech32.ConvertBits(groups, 5, 8, false)
+	if err != nil || len(data) == 0 {
+		return "", 0, nil, ErrorInvalidAddress
+	}
+	//types 0 to 3 combine a key or script payment credential with a key or script stake credential
+	if data[0]>>4 > 3 {
+		return "", 0, nil, ErrorNotBaseAddress
+	}
+	if len(data) != 1+28+28 {
+		return "", 0, nil, ErrorInvalidHashLength
+	}
+	return hrp, data[0], data[29:], nil
+}
+
+// CardanoStakeAddress returns the reward address, stake1 or stake_test1, of the stake credential
+// a cardano base address carries. The header byte is 0xe0 for a key and 0xf0 for a script credential,
+// with the network id of the base address.
+func CardanoStakeAddress(baseAddr string) (string, error) {
+	hrp, header, stake, err := cardanoBaseAddress(baseAddr)
+	if err != nil {
+		return "", err
+	}
+	stakeHeader := byte(0xe0)
+	if header&0x20 != 0 {
+		stakeHeader = 0xf0
+	}
+	stakeHeader |= header & 0x0f
+	return bech32.EncodeWithVariant(cardanoStakeHRP[hrp], BTCBech32Alphabet, catData([]byte{stakeHeader}, stake), nil, bech32.VariantBech32), nil
+}
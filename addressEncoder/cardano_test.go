@@ -0,0 +1,27 @@
+package addressEncoder
+
+import "testing"
+
+func Test_CardanoStakeAddress(t *testing.T) {
+	// CIP-19 test vectors
+	tests := map[string]string{
+		"addr1qx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer3n0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgse35a3x":      "stake1uyehkck0lajq8gr28t9uxnuvgcqrc6070x3k9r8048z8y5gh6ffgw",
+		"addr_test1qz2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer3n0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgs68faae": "stake_test1uqehkck0lajq8gr28t9uxnuvgcqrc6070x3k9r8048z8y5gssrtvn",
+		// a script stake credential
+		"addr1yx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzerkr0vd4msrxnuwnccdxlhdjar77j6lg0wypcc9uar5d2shs2z78ve": "stake178phkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gtcccycj5",
+	}
+	for base, expect := range tests {
+		if ret, err := CardanoStakeAddress(base); err != nil || ret != expect {
+			t.Errorf("%s: got %s, %v, want %s", base, ret, err, expect)
+		}
+	}
+
+	// an enterprise address has no stake credential
+	if _, err := CardanoStakeAddress("addr1vx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzers66hrl8"); err != ErrorNotBaseAddress {
+		t.Error("enterprise address:", err)
+	}
+	// a valid bech32 string of another chain
+	if _, err := CardanoStakeAddress("bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4"); err != ErrorInvalidAddress {
+		t.Error("bitcoin address:", err)
+	}
+}
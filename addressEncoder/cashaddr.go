@@ -0,0 +1,136 @@
+package addressEncoder
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/blocktree/go-owcdrivers/addressEncoder/base32PolyMod"
+)
+
+// cashaddr types, the high bits of the version byte
+const (
+	CashAddrP2PKH      = 0
+	CashAddrP2SH       = 1
+	CashAddrTokenP2PKH = 2 // CashTokens aware P2PKH, "z" after the prefix
+	CashAddrTokenP2SH  = 3 // CashTokens aware P2SH, "r" after the prefix
+)
+
+var (
+	ErrorCashAddrCharset  = errors.New("Invalid cashaddr character!")
+	ErrorCashAddrChecksum = errors.New("Invalid cashaddr checksum!")
+	ErrorCashAddrPrefix   = errors.New("Invalid cashaddr prefix!")
+	ErrorCashAddrLength   = errors.New("Invalid cashaddr length!")
+)
+
+func validateCashAddr(address, hrp string) error {
+	pos := strings.LastIndexByte(address, ':')
+	if pos == -1 || !strings.EqualFold(address[:pos], hrp) {
+		return ErrorCashAddrPrefix
+	}
+	_, err := base32PolyMod.Decode(address, BCHCashAlphabet)
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, base32PolyMod.ErrorInvalidCharacter):
+		return ErrorCashAddrCharset
+	case errors.Is(err, base32PolyMod.ErrorChecksumMismatch):
+		return ErrorCashAddrChecksum
+	case errors.Is(err, base32PolyMod.ErrorInvalidLength):
+		return ErrorCashAddrLength
+	}
+	return ErrorInvalidAddress
+}
+
+// ValidateCashAddrBatch validates each of addresses as a cashaddr with prefix hrp.
+// The result has an entry per address, nil for a valid one, otherwise one of
+// ErrorCashAddrCharset, ErrorCashAddrChecksum, ErrorCashAddrPrefix or ErrorCashAddrLength,
+// and ErrorInvalidAddress for anything else, such as a reserved version bit being set.
+func ValidateCashAddrBatch(addresses []string, hrp string) []error {
+	ret := make([]error, len(addresses))
+	for i, address := range addresses {
+		ret[i] = validateCashAddr(address, hrp)
+	}
+	return ret
+}
+
+// NormalizeCashAddr validates a cashaddr given in either case, with or without its prefix,
+// and returns its canonical lowercase prefixed form. An address without prefix is taken as
+// a "bitcoincash" one.
+func NormalizeCashAddr(addr string) (string, error) {
+	if strings.ToLower(addr) != addr && strings.ToUpper(addr) != addr {
+		return "", ErrorCashAddrCharset
+	}
+	ret := strings.ToLower(addr)
+	pos := strings.LastIndexByte(ret, ':')
+	if pos == -1 {
+		ret = "bitcoincash:" + ret
+		pos = len("bitcoincash")
+	}
+	if err := validateCashAddr(ret, ret[:pos]); err != nil {
+		return "", err
+	}
+	return ret, nil
+}
+
+// NormalizeCashAddrNoPrefix works like NormalizeCashAddr but returns the form without prefix
+func NormalizeCashAddrNoPrefix(addr string) (string, error) {
+	ret, err := NormalizeCashAddr(addr)
+	if err != nil {
+		return "", err
+	}
+	return ret[strings.LastIndexByte(ret, ':')+1:], nil
+}
+
+// legacyCashAddrTypes maps the legacy version bytes of BCH to the cashaddr prefix and type
+var legacyCashAddrTypes = map[byte]struct {
+	prefix   string
+	hashType byte
+}{
+	0x00: {"bitcoincash", 0},
+	0x05: {"bitcoincash", 1},
+	0x6F: {"bchtest", 0},
+	0xC4: {"bchtest", 1},
+}
+
+// LegacyToCashAddr converts a legacy P2PKH or P2SH address of BCH, or of BSV which uses the same
+// version bytes, to the cashaddr form. The type byte, 0 for P2PKH and 1 for P2SH, is taken from the legacy
+// version byte and testnet addresses get the "bchtest" prefix.
+func LegacyToCashAddr(address string) (string, error) {
+	legacy := AddressType{EncodeType: "base58", Alphabet: BCHLegacyAlphabet, ChecksumType: "doubleSHA256", HashLen: 20,
+		PrefixLen: func([]byte) int { return 1 }}
+	prefix, hash, err := AddressDecodePrefix(address, legacy)
+	if err != nil {
+		return "", err
+	}
+	t, ok := legacyCashAddrTypes[prefix[0]]
+	if !ok {
+		return "", ErrorInvalidAddress
+	}
+	return base32PolyMod.Encode(t.prefix, BCHCashAlphabet, append([]byte{t.hashType}, hash...)), nil
+}
+
+// CashAddrDecoded is a decoded cashaddr
+type CashAddrDecoded struct {
+	Prefix     string // the network prefix, in lower case
+	Type       byte   // one of CashAddrP2PKH, CashAddrP2SH, CashAddrTokenP2PKH and CashAddrTokenP2SH
+	Hash       []byte
+	TokenAware bool // whether the address may receive CashTokens
+}
+
+// DecodeCashAddr decodes a cashaddr of any prefix, given in either case with its prefix, and reports its
+// type and whether it is CashTokens aware. ErrorInvalidAddress is returned for the undefined types.
+func DecodeCashAddr(address string) (*CashAddrDecoded, error) {
+	t, hash, err := base32PolyMod.DecodeWithType(address, BCHCashAlphabet)
+	if err != nil {
+		return nil, ErrorInvalidAddress
+	}
+	if t > CashAddrTokenP2SH {
+		return nil, ErrorInvalidAddress
+	}
+	return &CashAddrDecoded{
+		Prefix:     strings.ToLower(address[:strings.LastIndexByte(address, ':')]),
+		Type:       t,
+		Hash:       hash,
+		TokenAware: t == CashAddrTokenP2PKH || t == CashAddrTokenP2SH,
+	}, nil
+}
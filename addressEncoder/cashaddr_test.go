@@ -0,0 +1,222 @@
+package addressEncoder
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/blocktree/go-owcdrivers/addressEncoder/base32PolyMod"
+)
+
+func Test_ValidateCashAddrBatch(t *testing.T) {
+	short := base32PolyMod.Encode("bitcoincash", BCHCashAlphabet, make([]byte, 11))
+	addresses := []string{
+		"bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a",
+		"BITCOINCASH:QPM2QSZNHKS23Z7629MMS6S4CWEF74VCWVY22GDX6A",
+		"bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdxba",
+		"bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6q",
+		"bchtest:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a",
+		"qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a",
+		short,
+	}
+	expect := []error{nil, nil, ErrorCashAddrCharset, ErrorCashAddrChecksum, ErrorCashAddrPrefix, ErrorCashAddrPrefix, ErrorCashAddrLength}
+
+	ret := ValidateCashAddrBatch(addresses, "bitcoincash")
+	if len(ret) != len(addresses) {
+		t.Fatalf("got %d results for %d addresses", len(ret), len(addresses))
+	}
+	for i := range addresses {
+		if ret[i] != expect[i] {
+			t.Errorf("%s: got %v, want %v", addresses[i], ret[i], expect[i])
+		}
+	}
+}
+
+func Test_NormalizeCashAddr(t *testing.T) {
+	canonical := "bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a"
+	for _, addr := range []string{
+		canonical,
+		"BITCOINCASH:QPM2QSZNHKS23Z7629MMS6S4CWEF74VCWVY22GDX6A",
+		"qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a",
+		"QPM2QSZNHKS23Z7629MMS6S4CWEF74VCWVY22GDX6A",
+	} {
+		ret, err := NormalizeCashAddr(addr)
+		if err != nil || ret != canonical {
+			t.Errorf("normalize %s: got %s, %v", addr, ret, err)
+		}
+		ret, err = NormalizeCashAddrNoPrefix(addr)
+		if err != nil || ret != canonical[len("bitcoincash:"):] {
+			t.Errorf("normalize %s without prefix: got %s, %v", addr, ret, err)
+		}
+	}
+
+	if _, err := NormalizeCashAddr("bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6A"); err != ErrorCashAddrCharset {
+		t.Errorf("mixed case should be rejected: %v", err)
+	}
+	if _, err := NormalizeCashAddr("qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6q"); err != ErrorCashAddrChecksum {
+		t.Errorf("bad checksum should be rejected: %v", err)
+	}
+}
+
+func Test_LegacyToCashAddr(t *testing.T) {
+	// vectors from the cashaddr specification
+	vectors := map[string]string{
+		"1BpEi6DfDAUFd7GtittLSdBeYJvcoaVggu": "bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a",
+		"1KXrWXciRDZUpQwQmuM1DbwsKDLYAYsVLR": "bitcoincash:qr95sy3j9xwd2ap32xkykttr4cvcu7as4y0qverfuy",
+		"16w1D5WRVKJuZUsSRzdLp9w3YGcgoxDXb":  "bitcoincash:qqq3728yw0y47sqn6l2na30mcw6zm78dzqre909m2r",
+		"3CWFddi6m4ndiGyKqzYvsFYagqDLPVMTzC": "bitcoincash:ppm2qsznhks23z7629mms6s4cwef74vcwvn0h829pq",
+		"3LDsS579y7sruadqu11beEJoTjdFiFCdX4": "bitcoincash:pr95sy3j9xwd2ap32xkykttr4cvcu7as4yc93ky28e",
+		"31nwvkZwyPdgzjBJZXfDmSWsC4ZLKpYyUw": "bitcoincash:pqq3728yw0y47sqn6l2na30mcw6zm78dzq5ucqzc37",
+	}
+	for legacy, cash := range vectors {
+		ret, err := LegacyToCashAddr(legacy)
+		if err != nil || ret != cash {
+			t.Errorf("%s: got %s, %v, want %s", legacy, ret, err, cash)
+		}
+		// the type byte follows the legacy version, the hash is kept
+		data, _ := AddressDecode(ret, BCH_mainnetAddressCash)
+		legacyType := BCH_mainnetAddressLegacy
+		legacyType.PrefixLen = func([]byte) int { return 1 }
+		_, hash, _ := AddressDecodePrefix(legacy, legacyType)
+		if len(data) != 21 || data[0] != map[byte]byte{'1': 0, '3': 8}[legacy[0]] || !bytes.Equal(data[1:], hash) {
+			t.Errorf("%s: cashaddr payload %x", legacy, data)
+		}
+	}
+
+	hash, _ := hex.DecodeString("76a04053bda0a88bda5177b86a15c3b29f559873")
+	testnet, err := LegacyToCashAddr(AddressEncode(hash, BSV_testnetAddressP2PKH))
+	if err != nil || testnet[:len("bchtest:q")] != "bchtest:q" {
+		t.Errorf("testnet P2PKH conversion: %s, %v", testnet, err)
+	}
+	if err := validateCashAddr(testnet, "bchtest"); err != nil {
+		t.Errorf("converted testnet address does not decode: %v", err)
+	}
+
+	if _, err := LegacyToCashAddr(AddressEncode(hash, LTC_mainnetAddressP2PKH)); err != ErrorInvalidAddress {
+		t.Errorf("litecoin address converted: %v", err)
+	}
+}
+
+func Test_BSV_address(t *testing.T) {
+	hash, _ := hex.DecodeString("76a04053bda0a88bda5177b86a15c3b29f559873")
+	vectors := []struct {
+		addresstype AddressType
+		address     string
+	}{
+		{BSV_mainnetAddressP2PKH, "1BpEi6DfDAUFd7GtittLSdBeYJvcoaVggu"},
+		{BSV_mainnetAddressP2SH, "3CWFddi6m4ndiGyKqzYvsFYagqDLPVMTzC"},
+	}
+	for _, v := range vectors {
+		if address := AddressEncode(hash, v.addresstype); address != v.address {
+			t.Errorf("encode got %s, want %s", address, v.address)
+		}
+		ret, err := AddressDecode(v.address, v.addresstype)
+		if err != nil || hex.EncodeToString(ret) != hex.EncodeToString(hash) {
+			t.Errorf("decode %s: %x, %v", v.address, ret, err)
+		}
+	}
+	for _, at := range []AddressType{BSV_testnetAddressP2PKH, BSV_testnetAddressP2SH} {
+		ret, err := AddressDecode(AddressEncode(hash, at), at)
+		if err != nil || hex.EncodeToString(ret) != hex.EncodeToString(hash) {
+			t.Errorf("testnet round trip with prefix %x: %x, %v", at.Prefix, ret, err)
+		}
+	}
+}
+
+func Test_CashTokens_address(t *testing.T) {
+	// the four types of one hash, as in the CashTokens specification; vectors computed with an independent cashaddr
+	hash, _ := hex.DecodeString("76a04053bda0a88bda5177b86a15c3b29f559873")
+	vectors := []struct {
+		address     string
+		addresstype AddressType
+		cashType    byte
+		tokenAware  bool
+	}{
+		{"bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a", BCH_mainnetAddressCashP2PKH, CashAddrP2PKH, false},
+		{"bitcoincash:ppm2qsznhks23z7629mms6s4cwef74vcwvn0h829pq", BCH_mainnetAddressCashP2SH, CashAddrP2SH, false},
+		{"bitcoincash:zpm2qsznhks23z7629mms6s4cwef74vcwvrqekrq9w", BCH_mainnetAddressCashTokenP2PKH, CashAddrTokenP2PKH, true},
+		{"bitcoincash:rpm2qsznhks23z7629mms6s4cwef74vcwv59yeyr7n", BCH_mainnetAddressCashTokenP2SH, CashAddrTokenP2SH, true},
+		{"bchtest:zpm2qsznhks23z7629mms6s4cwef74vcwv8ja3phzj", BCH_testnetAddressCashTokenP2PKH, CashAddrTokenP2PKH, true},
+		{"bchtest:rpm2qsznhks23z7629mms6s4cwef74vcwvshq7x5e0", BCH_testnetAddressCashTokenP2SH, CashAddrTokenP2SH, true},
+	}
+	for _, v := range vectors {
+		if ret := AddressEncode(hash, v.addresstype); ret != v.address {
+			t.Errorf("encode type %d: got %s, want %s", v.cashType, ret, v.address)
+		}
+		data, err := AddressDecode(v.address, v.addresstype)
+		if err != nil || !bytes.Equal(data, hash) {
+			t.Errorf("%s: decoded %x, %v", v.address, data, err)
+		}
+		decoded, err := DecodeCashAddr(v.address)
+		if err != nil || decoded.Type != v.cashType || decoded.TokenAware != v.tokenAware || !bytes.Equal(decoded.Hash, hash) {
+			t.Errorf("%s: DecodeCashAddr gave %+v, %v", v.address, decoded, err)
+		}
+		// the preset without type takes any of them
+		if _, err := AddressDecode(v.address, BCH_mainnetAddressCash.WithHRP(v.addresstype.ChecksumType)); err != nil {
+			t.Errorf("%s: not decoded without type: %v", v.address, err)
+		}
+	}
+
+	// a token aware address is no plain P2PKH one
+	if _, err := AddressDecode(vectors[2].address, BCH_mainnetAddressCashP2PKH); err != ErrorInvalidAddress {
+		t.Error("token aware address decoded as P2PKH:", err)
+	}
+	if decoded, err := DecodeCashAddr(strings.ToUpper(vectors[0].address)); err != nil || decoded.Prefix != "bitcoincash" {
+		t.Errorf("upper case address: %+v, %v", decoded, err)
+	}
+}
+
+func Test_CashAddr_type_char(t *testing.T) {
+	// the first character after the prefix is the type: q for P2PKH, p for P2SH
+	p2pkh := "bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a"
+	p2sh := "bitcoincash:ppm2qsznhks23z7629mms6s4cwef74vcwvn0h829pq"
+	if decoded, err := DecodeCashAddr(p2pkh); err != nil || decoded.Type != CashAddrP2PKH {
+		t.Errorf("%s: %+v, %v", p2pkh, decoded, err)
+	}
+	if decoded, err := DecodeCashAddr(p2sh); err != nil || decoded.Type != CashAddrP2SH {
+		t.Errorf("%s: %+v, %v", p2sh, decoded, err)
+	}
+	// the presets of a type refuse the other one
+	if _, err := AddressDecode(p2sh, BCH_mainnetAddressCashP2PKH); err != ErrorInvalidAddress {
+		t.Error("P2SH address decoded as P2PKH:", err)
+	}
+	if _, err := AddressDecode(p2pkh, BCH_mainnetAddressCashP2SH); err != ErrorInvalidAddress {
+		t.Error("P2PKH address decoded as P2SH:", err)
+	}
+}
+
+func Test_CashAddr_network(t *testing.T) {
+	// one hash on both networks, the prefix is the only difference
+	mainnet := "bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a"
+	testnet := "bchtest:qpm2qsznhks23z7629mms6s4cwef74vcwvqcw003ap"
+	if _, err := AddressDecode(mainnet, BCH_mainnetAddressCashP2PKH); err != nil {
+		t.Error("mainnet address:", err)
+	}
+	if _, err := AddressDecode(testnet, BCH_testnetAddressCashP2PKH); err != nil {
+		t.Error("testnet address:", err)
+	}
+	if _, err := AddressDecode(testnet, BCH_mainnetAddressCashP2PKH); err != ErrorInvalidAddress {
+		t.Error("testnet address decoded as mainnet:", err)
+	}
+	if _, err := AddressDecode(mainnet, BCH_testnetAddressCashP2PKH); err != ErrorInvalidAddress {
+		t.Error("mainnet address decoded as testnet:", err)
+	}
+	if _, err := AddressDecode(strings.ToUpper(testnet), BCH_mainnetAddressCash); err != ErrorInvalidAddress {
+		t.Error("upper case testnet address decoded as mainnet:", err)
+	}
+
+	// without its prefix an address takes the one of the preset, which the checksum is then checked with
+	if hash, err := AddressDecode("qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a", BCH_mainnetAddressCashP2PKH); err != nil || hex.EncodeToString(hash) != "76a04053bda0a88bda5177b86a15c3b29f559873" {
+		t.Errorf("mainnet address without prefix: %x, %v", hash, err)
+	}
+	if _, err := AddressDecode("QPM2QSZNHKS23Z7629MMS6S4CWEF74VCWVQCW003AP", BCH_testnetAddressCashP2PKH); err != nil {
+		t.Error("upper case testnet address without prefix:", err)
+	}
+	if _, err := AddressDecode("qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a", BCH_testnetAddressCashP2PKH); err != ErrorInvalidAddress {
+		t.Error("mainnet address without prefix decoded as testnet:", err)
+	}
+	if ret, err := AddressDecodeResult("qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a", BCH_mainnetAddressCash); err != nil || ret.HRP != "bitcoincash" {
+		t.Errorf("decode result without prefix: %+v, %v", ret, err)
+	}
+}
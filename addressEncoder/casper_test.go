@@ -0,0 +1,67 @@
+package addressEncoder
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func Test_CSPR_address(t *testing.T) {
+	vectors := []struct {
+		pubkey, publicKeyHex, accountHash string
+		publicKey, account                AddressType
+	}{
+		{
+			"d75a980182b10ab7d54bfed3c964073a0ee172f3daa62325af021a68f707511a",
+			"01d75a980182b10ab7d54bfed3c964073a0ee172f3daa62325af021a68f707511a",
+			"account-hash-b6c0e5c9ee25f43f57e577b5821688b9ac164eb7c4c08a24d43d1806ac721342",
+			CSPR_publicKeyEd25519, CSPR_accountHashEd25519,
+		},
+		{
+			"0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798",
+			"020279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798",
+			"account-hash-86937931937ee0281e50806b94f8d4993e8869b0689dfa0a21d2946ab677183c",
+			CSPR_publicKeySecp256k1, CSPR_accountHashSecp256k1,
+		},
+	}
+	for _, v := range vectors {
+		pubkey, _ := hex.DecodeString(v.pubkey)
+
+		if ret := AddressEncode(pubkey, v.publicKey); ret != v.publicKeyHex {
+			t.Error("public key encode wrong result:", ret)
+		}
+		ret, err := AddressDecode(v.publicKeyHex, v.publicKey)
+		if err != nil || hex.EncodeToString(ret) != v.pubkey {
+			t.Error("public key decode wrong result:", hex.EncodeToString(ret), err)
+		}
+
+		if ret, err := GenerateAddress(pubkey, v.account); err != nil || ret != v.accountHash {
+			t.Error("account hash wrong result:", ret, err)
+		}
+		ret, err = AddressDecode(v.accountHash, v.account)
+		if err != nil || hex.EncodeToString(ret) != v.accountHash[len("account-hash-"):] {
+			t.Error("account hash decode wrong result:", hex.EncodeToString(ret), err)
+		}
+	}
+
+	// a 32-byte ed25519 key is taken as the account hash itself by AddressEncode, only GenerateAddress hashes it
+	pubkey, _ := hex.DecodeString(vectors[0].pubkey)
+	if ret := AddressEncode(pubkey, CSPR_accountHashEd25519); ret != "account-hash-"+vectors[0].pubkey {
+		t.Error("AddressEncode of an ed25519 key wrong result:", ret)
+	}
+	// a 33-byte secp256k1 key is not an account hash and AddressEncode hashes it
+	pubkey, _ = hex.DecodeString(vectors[1].pubkey)
+	if ret := AddressEncode(pubkey, CSPR_accountHashSecp256k1); ret != vectors[1].accountHash {
+		t.Error("AddressEncode of a secp256k1 key wrong result:", ret)
+	}
+
+	// the algorithm byte must match
+	if _, err := AddressDecode(vectors[1].publicKeyHex, CSPR_publicKeyEd25519); err == nil {
+		t.Error("secp256k1 key decoded as ed25519")
+	}
+	if _, err := AddressDecode(vectors[0].accountHash[len("account-hash-"):], CSPR_accountHashEd25519); err == nil {
+		t.Error("account hash without prefix decoded")
+	}
+	if _, err := AddressDecode(vectors[0].accountHash[:len(vectors[0].accountHash)-2], CSPR_accountHashEd25519); err != ErrorInvalidHashLength {
+		t.Error("short account hash decoded:", err)
+	}
+}
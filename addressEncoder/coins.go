@@ -0,0 +1,47 @@
+package addressEncoder
+
+// Coins maps the ticker of a coin to the AddressType of its usual mainnet receiving address,
+// the legacy P2PKH form for the bitcoin family. Use the presets directly for the other forms.
+// It is the set of coins with a preset in this package, each tested with a published address,
+// and not a vetted top coins list: it holds small chains such as WICC and HC and lacks large ones
+// such as XLM and SOL, which have no preset here. Cardano and the SS58 networks such as Polkadot
+// are handled by CardanoStakeAddress, EncodeSS58 and DecodeSS58 instead of a preset.
+var Coins = map[string]AddressType{
+	"BTC":  BTC_mainnetAddressP2PKH,
+	"LTC":  LTC_mainnetAddressP2PKH,
+	"DOGE": DOGE_mainnetAddressP2PKH,
+	"BCH":  BCH_mainnetAddressCash,
+	"BSV":  BSV_mainnetAddressP2PKH,
+	"ZEC":  ZEC_mainnet_t_AddressP2PKH,
+	"DCR":  DCRD_mainnetAddressP2PKH,
+	"FIRO": FIRO_mainnetAddressP2PKH,
+	"PIVX": PIVX_mainnetAddressP2PKH,
+	"BTM":  BTM_mainnetAddressBech32V0,
+	"ETH":  ETH_mainnetPublicAddress,
+	"XRP":  XRP_Address,
+	"XMR":  XMR_mainnetPublicAddress,
+	"XTZ":  XTZ_mainnetAddress_tz1,
+	"EOS":  EOS_mainnetPublic,
+	"TRX":  TRON_mainnetAddress,
+	"ATOM": ATOM_mainnetAddress,
+	"LUNA": LUNA_mainnetAddress,
+	"BNB":  BNB_mainnetAddress,
+	"ONT":  ONT_Address,
+	"AE":   AE_mainnetAddress,
+	"ICX":  ICX_walletAddress,
+	"FIL":  FIL_mainnetAddressSecp256k1,
+	"IOTA": IOTA_mainnetAddressEd25519,
+	"SMR":  SMR_mainnetAddressEd25519,
+	"KAS":  KAS_mainnetAddressSchnorr,
+	"QTUM": QTUM_mainnetAddressP2PKH,
+	"NAS":  NAS_AccountAddress,
+	"VSYS": VSYS_mainnetAddress,
+	"ELA":  ELA_Address,
+	"WICC": WICC_mainnetAddressP2PKH,
+	"HC":   HC_mainnetAddressP2PKH,
+	"EVA":  EVA_mainnetAddress,
+	"ZIL":  ZIL_mainnetAddress,
+	"ONE":  ONE_mainnetAddress,
+	"RVN":  RVN_mainnetAddressP2PKH,
+	"NMC":  NMC_mainnetAddressP2PKH,
+}
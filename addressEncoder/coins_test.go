@@ -0,0 +1,61 @@
+package addressEncoder
+
+import "testing"
+
+func Test_Coins(t *testing.T) {
+	// published addresses: block explorers, wikis, donation addresses and the specifications of the formats
+	vectors := map[string]string{
+		"BTC":  "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa",
+		"LTC":  "LVg2kJoFNg45Nbpy53h7Fe1wKyeXVRhMH9",
+		"DOGE": "DH5yaieqoZN36fDVciNyRueRGvGLR3mr7L",
+		"BCH":  "bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a",
+		"BSV":  "1BpEi6DfDAUFd7GtittLSdBeYJvcoaVggu",
+		"ZEC":  "t1Hsc1LR8yKnbbe3twRp88p6vFfC5t7DLbs",
+		"DCR":  "DsUZxxoHJSty8DCfwfartwTYbuhmVct7tJu",
+		"FIRO": "a8ULhhDgfdSiXJhSZVdhb8EuDc6R3ogsaM",
+		"PIVX": "DMJRSsuU9zfyrvxVaAEFQqK4MxZg6vgeS6",
+		"BTM":  "bm1q5p9d4gelfm4cc3zq3slj7vh2njx23ma2cf866j",
+		"ETH":  "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		"XRP":  "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+		"XMR":  "44AFFq5kSiGBoZ4NMDwYtN18obc8AemS33DBLWs3H7otXft3XjrpDtQGv7SqSsaBYBb98uNbr2VBBEt7f2wfn3RVGQBEP3A",
+		"XTZ":  "tz1KqTpEZ7Yob7QbPE4Hy4Wo8fHG8LhKxZSx",
+		"EOS":  "EOS6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5GDW5CV",
+		"TRX":  "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t",
+		"ATOM": "cosmos19rl4cm2hmr8afy4kldpxz3fka4jguq0auqdal4",
+		"LUNA": "terra1dcegyrekltswvyy0xy69ydgxn9x8x32zdtapd8",
+		"BNB":  "bnb1grpf0955h0ykzq3ar5nmum7y6gdfl6lxfn46h2",
+		"ONT":  "AcyLq3tokVpkMBMLALVMWRdVJ83TTgBUwU",
+		"AE":   "ak_2swhLkgBPeeADxVTAVCJnZLY5NZtCFiM93JxsEaMuC59euuFRQ",
+		"ICX":  "hxbe258ceb872e08851f1f59694dac2558708ece11",
+		"FIL":  "f17uoq6tp427uzv7fztkbsnn64iwotfrristwpryy",
+		"IOTA": "iota1qrhacyfwlcnzkvzteumekfkrrwks98mpdm37cj4xx3drvmjvnep6xqgyzyx",
+		"SMR":  "smr1qrhacyfwlcnzkvzteumekfkrrwks98mpdm37cj4xx3drvmjvnep6xhcazjh",
+		"KAS":  "kaspa:qqkqkzjvr7zwxxmjxjkmxxdwju9kjs6e9u82uh59z07vgaks6gg62v8707g73",
+		"QTUM": "QjwHHRHUzaPebgmDkrtx3CxkchtDW5eB9w",
+		"NAS":  "n1TV3sU6jyzR4rJ1D7jCAmtVGSntJagXZHC",
+		"VSYS": "ARQEGuxzau9ZSsPgWWHNJYgVPUxJYQeGb4F",
+		"ELA":  "Eb1r8zaS3qbsRFH4j4GADshJCqFZ84ZM8u",
+		"WICC": "WbP5WTty9jz6tAsAXwJMAinURp8fFdbDwL",
+		"HC":   "HsDCFUx2LzWBuaDjqpZZ9GZcZ9eQEoyuke9",
+		"EVA":  "eva1pn80qt83wzk9w4gs3muc8hw26cexlgav75mar0",
+		"ZIL":  "zil1n0lvw9dxh4jcljmzkruvexl69t08zs62ds9ats",
+		"ONE":  "one1a0x3d6xpmr6f8wsyaxd9v36pytvp48zckswvv9",
+		"RVN":  "RXBurnXXXXXXXXXXXXXXXXXXXXXXWUo9FV",
+		"NMC":  "N2pGWAh65TWpWmEFrFssRQkQubbczJSKi9",
+	}
+	for coin, addresstype := range Coins {
+		address, ok := vectors[coin]
+		if !ok {
+			t.Errorf("%s has no test vector", coin)
+			continue
+		}
+		data, err := AddressDecode(address, addresstype)
+		if err != nil {
+			t.Errorf("%s: decode %s failed: %v", coin, address, err)
+			continue
+		}
+		if ret := canonicalAddress(data, addresstype); ret != address {
+			t.Errorf("%s: round trip gave %s, want %s", coin, ret, address)
+		}
+	}
+}
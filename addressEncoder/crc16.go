@@ -0,0 +1,17 @@
+package addressEncoder
+
+// crc16 computes the CRC16-XMODEM(poly 0x1021, init 0) of s
+func crc16(s []byte) uint16 {
+	var crc uint16
+	for i := 0; i < len(s); i++ {
+		crc ^= uint16(s[i]) << 8
+		for j := 0; j < 8; j++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
@@ -20,6 +20,8 @@ var (
 	TRONAlphabet       = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
 	VSYSAlphabet       = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
 	ATOMBech32Alphabet = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+	KASAlphabet        = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+	FILAlphabet        = "abcdefghijklmnopqrstuvwxyz234567"
 )
 
 type AddressType struct {
@@ -28,198 +30,317 @@ type AddressType struct {
 	ChecksumType string //checksum类型(Prefix string when encode type is base32PolyMod)
 	HashType     string //地址hash类型，传入数据为公钥时起效
 	HashLen      int    //编码前的数据长度
+	HashLens     []int  //解码时接受的数据长度，为空时只接受HashLen
+	ScriptHash   bool   //数据为脚本hash(P2SH)而不是公钥hash
 	Prefix       []byte //数据前面的填充
 	Suffix       []byte //数据后面的填充
+
+	Bech32Variant string //bech32 checksum类型(bech32/bech32m)，为空时按见证版本决定
+
+	HashTruncateOffset int //hash结果截取的起始位置，负数时从末尾倒数
+	HashTruncateLength int //hash结果截取的长度，为0时不截取
+
+	PrefixLen  func(data []byte) int       `json:"-"` //解码时根据前导字节确定前缀长度，设置后替代Prefix用于解码
+	PrefixFunc func(payload []byte) []byte `json:"-"` //base58编码时根据数据计算前缀，解码时按数据校验前缀，设置后替代Prefix，返回nil表示不支持该数据
+
+	CaseFold string //解码时的大小写规则(none/lowerOnly/insensitive/eip55)，为空时按编码类型决定
+
+	ChecksumScope string //base58 checksum的计算范围(all/noPrefix/noSuffix/hash)，为空时为Prefix+hash+Suffix
 }
 
 //func (at *AddressType) Prefix() []byte {
 //	return at.Prefix
 //}
 
+// copyBytes copies b, keeping nil as nil
+func copyBytes(b []byte) []byte {
+	if b == nil {
+		return nil
+	}
+	return append([]byte{}, b...)
+}
+
+// Clone returns a copy of at which shares no Prefix, Suffix or HashLens memory with it
+func (at AddressType) Clone() AddressType {
+	ret := at
+	ret.Prefix = copyBytes(at.Prefix)
+	ret.Suffix = copyBytes(at.Suffix)
+	if at.HashLens != nil {
+		ret.HashLens = append([]int{}, at.HashLens...)
+	}
+	return ret
+}
+
+// WithPrefix returns a copy of at with prefix as Prefix
+func (at AddressType) WithPrefix(prefix []byte) AddressType {
+	ret := at.Clone()
+	ret.Prefix = copyBytes(prefix)
+	return ret
+}
+
+// WithHRP returns a copy of at with the human readable part hrp, which bech32 and base32PolyMod
+// types keep in ChecksumType
+func (at AddressType) WithHRP(hrp string) AddressType {
+	return at.WithChecksumType(hrp)
+}
+
+// WithChecksumType returns a copy of at with checksumType as ChecksumType
+func (at AddressType) WithChecksumType(checksumType string) AddressType {
+	ret := at.Clone()
+	ret.ChecksumType = checksumType
+	return ret
+}
+
 var (
 	//BTC stuff
-	BTC_mainnetAddressP2PKH         = AddressType{"base58", BTCAlphabet, "doubleSHA256", "h160", 20, []byte{0x00}, nil}
-	BTC_mainnetAddressP2SH          = AddressType{"base58", BTCAlphabet, "doubleSHA256", "h160", 20, []byte{0x05}, nil}
-	BTC_mainnetAddressBech32V0      = AddressType{"bech32", BTCBech32Alphabet, "bc", "h160", 20, []byte{0}, nil}
-	BTC_mainnetPrivateWIF           = AddressType{"base58", BTCAlphabet, "doubleSHA256", "", 32, []byte{0x80}, nil}
-	BTC_mainnetPrivateWIFCompressed = AddressType{"base58", BTCAlphabet, "doubleSHA256", "", 32, []byte{0x80}, []byte{0x01}}
-	BTC_mainnetPublicBIP32          = AddressType{"base58", BTCAlphabet, "doubleSHA256", "", 74, []byte{0x04, 0x88, 0xB2, 0x1E}, nil}
-	BTC_mainnetPrivateBIP32         = AddressType{"base58", BTCAlphabet, "doubleSHA256", "", 74, []byte{0x04, 0x88, 0xAD, 0xE4}, nil}
-	BTC_testnetAddressP2PKH         = AddressType{"base58", BTCAlphabet, "doubleSHA256", "h160", 20, []byte{0x6F}, nil}
-	BTC_testnetAddressP2SH          = AddressType{"base58", BTCAlphabet, "doubleSHA256", "h160", 20, []byte{0xC4}, nil}
-	BTC_testnetAddressBech32V0      = AddressType{"bech32", BTCBech32Alphabet, "tb", "h160", 20, []byte{0}, nil}
-	BTC_testnetPrivateWIF           = AddressType{"base58", BTCAlphabet, "doubleSHA256", "", 32, []byte{0xEF}, nil}
-	BTC_testnetPrivateWIFCompressed = AddressType{"base58", BTCAlphabet, "doubleSHA256", "", 32, []byte{0xEF}, []byte{0x01}}
-	BTC_testnetPublicBIP32          = AddressType{"base58", BTCAlphabet, "doubleSHA256", "", 74, []byte{0x04, 0x35, 0x87, 0xCF}, nil}
-	BTC_testnetPrivateBIP32         = AddressType{"base58", BTCAlphabet, "doubleSHA256", "", 74, []byte{0x04, 0x35, 0x83, 0x94}, nil}
+	BTC_mainnetAddressP2PKH         = AddressType{EncodeType: "base58", Alphabet: BTCAlphabet, ChecksumType: "doubleSHA256", HashType: "h160", HashLen: 20, Prefix: []byte{0x00}}
+	BTC_mainnetAddressP2SH          = AddressType{EncodeType: "base58", Alphabet: BTCAlphabet, ChecksumType: "doubleSHA256", HashType: "h160", HashLen: 20, ScriptHash: true, Prefix: []byte{0x05}}
+	BTC_mainnetAddressBech32V0      = AddressType{EncodeType: "bech32", Alphabet: BTCBech32Alphabet, ChecksumType: "bc", HashType: "h160", HashLen: 20, HashLens: []int{20, 32}, Prefix: []byte{0}}
+	BTC_mainnetPrivateWIF           = AddressType{EncodeType: "base58", Alphabet: BTCAlphabet, ChecksumType: "doubleSHA256", HashLen: 32, Prefix: []byte{0x80}}
+	BTC_mainnetPrivateWIFCompressed = AddressType{EncodeType: "base58", Alphabet: BTCAlphabet, ChecksumType: "doubleSHA256", HashLen: 32, Prefix: []byte{0x80}, Suffix: []byte{0x01}}
+	BTC_mainnetPublicBIP32          = AddressType{EncodeType: "base58", Alphabet: BTCAlphabet, ChecksumType: "doubleSHA256", HashLen: 74, Prefix: []byte{0x04, 0x88, 0xB2, 0x1E}}
+	BTC_mainnetPrivateBIP32         = AddressType{EncodeType: "base58", Alphabet: BTCAlphabet, ChecksumType: "doubleSHA256", HashLen: 74, Prefix: []byte{0x04, 0x88, 0xAD, 0xE4}}
+	BTC_testnetAddressP2PKH         = AddressType{EncodeType: "base58", Alphabet: BTCAlphabet, ChecksumType: "doubleSHA256", HashType: "h160", HashLen: 20, Prefix: []byte{0x6F}}
+	BTC_testnetAddressP2SH          = AddressType{EncodeType: "base58", Alphabet: BTCAlphabet, ChecksumType: "doubleSHA256", HashType: "h160", HashLen: 20, ScriptHash: true, Prefix: []byte{0xC4}}
+	BTC_testnetAddressBech32V0      = AddressType{EncodeType: "bech32", Alphabet: BTCBech32Alphabet, ChecksumType: "tb", HashType: "h160", HashLen: 20, HashLens: []int{20, 32}, Prefix: []byte{0}}
+	BTC_testnetPrivateWIF           = AddressType{EncodeType: "base58", Alphabet: BTCAlphabet, ChecksumType: "doubleSHA256", HashLen: 32, Prefix: []byte{0xEF}}
+	BTC_testnetPrivateWIFCompressed = AddressType{EncodeType: "base58", Alphabet: BTCAlphabet, ChecksumType: "doubleSHA256", HashLen: 32, Prefix: []byte{0xEF}, Suffix: []byte{0x01}}
+	BTC_testnetPublicBIP32          = AddressType{EncodeType: "base58", Alphabet: BTCAlphabet, ChecksumType: "doubleSHA256", HashLen: 74, Prefix: []byte{0x04, 0x35, 0x87, 0xCF}}
+	BTC_testnetPrivateBIP32         = AddressType{EncodeType: "base58", Alphabet: BTCAlphabet, ChecksumType: "doubleSHA256", HashLen: 74, Prefix: []byte{0x04, 0x35, 0x83, 0x94}}
+	BTC_mainnetAddressP2WSH         = AddressType{EncodeType: "bech32", Alphabet: BTCBech32Alphabet, ChecksumType: "bc", HashLen: 32, Prefix: []byte{0}}
+	BTC_testnetAddressP2WSH         = AddressType{EncodeType: "bech32", Alphabet: BTCBech32Alphabet, ChecksumType: "tb", HashLen: 32, Prefix: []byte{0}}
+	BTC_mainnetAddressTaproot       = AddressType{EncodeType: "bech32", Alphabet: BTCBech32Alphabet, ChecksumType: "bc", HashLen: 32, Prefix: []byte{1}}
+	BTC_testnetAddressTaproot       = AddressType{EncodeType: "bech32", Alphabet: BTCBech32Alphabet, ChecksumType: "tb", HashLen: 32, Prefix: []byte{1}}
 
 	//XMR stuff
-	XMR_mainnetPublicAddress           = AddressType{"XMR", XMRAlphabet, "keccak256", "", 64, []byte{0x12}, nil}
-	XMR_mainnetPublicSubAddress        = AddressType{"XMR", XMRAlphabet, "keccak256", "", 64, []byte{0x2A}, nil}
-	XMR_mainnetPublicIntegratedAddress = AddressType{"XMR", XMRAlphabet, "keccak256", "payID", 72, []byte{0x13}, nil}
-	XMR_testnetPublicAddress           = AddressType{"XMR", XMRAlphabet, "keccak256", "", 64, []byte{0x35}, nil}
-	XMR_testnetPublicSubAddress        = AddressType{"XMR", XMRAlphabet, "keccak256", "", 64, []byte{0x3f}, nil}
-	XMR_testnetPublicIntegratedAddress = AddressType{"XMR", XMRAlphabet, "keccak256", "payID", 72, []byte{0x36}, nil}
+	XMR_mainnetPublicAddress           = AddressType{EncodeType: "XMR", Alphabet: XMRAlphabet, ChecksumType: "keccak256", HashLen: 64, Prefix: []byte{0x12}}
+	XMR_mainnetPublicSubAddress        = AddressType{EncodeType: "XMR", Alphabet: XMRAlphabet, ChecksumType: "keccak256", HashLen: 64, Prefix: []byte{0x2A}}
+	XMR_mainnetPublicIntegratedAddress = AddressType{EncodeType: "XMR", Alphabet: XMRAlphabet, ChecksumType: "keccak256", HashType: "payID", HashLen: 72, Prefix: []byte{0x13}}
+	XMR_testnetPublicAddress           = AddressType{EncodeType: "XMR", Alphabet: XMRAlphabet, ChecksumType: "keccak256", HashLen: 64, Prefix: []byte{0x35}}
+	XMR_testnetPublicSubAddress        = AddressType{EncodeType: "XMR", Alphabet: XMRAlphabet, ChecksumType: "keccak256", HashLen: 64, Prefix: []byte{0x3f}}
+	XMR_testnetPublicIntegratedAddress = AddressType{EncodeType: "XMR", Alphabet: XMRAlphabet, ChecksumType: "keccak256", HashType: "payID", HashLen: 72, Prefix: []byte{0x36}}
 
 	//DOGE stuff
-	//DOGE_singleSignAddressP2PKH = AddressType{"base58", BTCAlphabet, "doubleSHA256", "h160", 20, []byte{0x16}, nil}
-	DOGE_multiSignAddressP2PKH = AddressType{"base58", BTCAlphabet, "doubleSHA256", "h160", 20, []byte{0x16}, nil}
+	//DOGE_singleSignAddressP2PKH = AddressType{EncodeType: "base58", Alphabet: BTCAlphabet, ChecksumType: "doubleSHA256", HashType: "h160", HashLen: 20, Prefix: []byte{0x16}}
+	DOGE_multiSignAddressP2PKH = AddressType{EncodeType: "base58", Alphabet: BTCAlphabet, ChecksumType: "doubleSHA256", HashType: "h160", HashLen: 20, Prefix: []byte{0x16}}
+	DOGE_mainnetAddressP2PKH   = AddressType{EncodeType: "base58", Alphabet: BTCAlphabet, ChecksumType: "doubleSHA256", HashType: "h160", HashLen: 20, Prefix: []byte{0x1E}}
+	DOGE_mainnetAddressP2SH    = AddressType{EncodeType: "base58", Alphabet: BTCAlphabet, ChecksumType: "doubleSHA256", HashType: "h160", HashLen: 20, ScriptHash: true, Prefix: []byte{0x16}}
+	DOGE_testnetAddressP2PKH   = AddressType{EncodeType: "base58", Alphabet: BTCAlphabet, ChecksumType: "doubleSHA256", HashType: "h160", HashLen: 20, Prefix: []byte{0x71}}
+	DOGE_testnetAddressP2SH    = AddressType{EncodeType: "base58", Alphabet: BTCAlphabet, ChecksumType: "doubleSHA256", HashType: "h160", HashLen: 20, ScriptHash: true, Prefix: []byte{0xC4}}
 	//ONT stuff
-	ONT_Address = AddressType{"base58", OntAlphabet, "doubleSHA256", "h160", 20, []byte{0x17}, nil}
+	ONT_Address = AddressType{EncodeType: "base58", Alphabet: OntAlphabet, ChecksumType: "doubleSHA256", HashType: "h160", HashLen: 20, Prefix: []byte{0x17}}
 	//XRP stuff
-	XRP_Address = AddressType{"base58", XRPAlphabet, "doubleSHA256", "h160", 20, []byte{0x00}, nil}
+	XRP_Address = AddressType{EncodeType: "base58", Alphabet: XRPAlphabet, ChecksumType: "doubleSHA256", HashType: "h160", HashLen: 20, Prefix: []byte{0x00}}
 	//BTM stuff
-	BTM_mainnetAddressBech32V0 = AddressType{"bech32", BTCBech32Alphabet, "bm", "h160", 20, []byte{0}, nil}
-	BTM_testnetAddressBech32V0 = AddressType{"bech32", BTCBech32Alphabet, "tm", "h160", 20, []byte{0}, nil}
+	BTM_mainnetAddressBech32V0 = AddressType{EncodeType: "bech32", Alphabet: BTCBech32Alphabet, ChecksumType: "bm", HashType: "h160", HashLen: 20, HashLens: []int{20, 32}, Prefix: []byte{0}}
+	BTM_testnetAddressBech32V0 = AddressType{EncodeType: "bech32", Alphabet: BTCBech32Alphabet, ChecksumType: "tm", HashType: "h160", HashLen: 20, HashLens: []int{20, 32}, Prefix: []byte{0}}
 	//ZEC stuff
-	ZEC_mainnet_t_AddressP2PKH = AddressType{"base58", ZECAlphabet, "doubleSHA256", "h160", 20, []byte{0x1C, 0xB8}, nil}
-	ZEC_mainnet_t_AddressP2SH  = AddressType{"base58", ZECAlphabet, "doubleSHA256", "h160", 20, []byte{0x1C, 0xBD}, nil}
-	ZEC_testnet_t_AddressP2PKH = AddressType{"base58", ZECAlphabet, "doubleSHA256", "h160", 20, []byte{0x1D, 0x25}, nil}
-	ZEC_testnet_t_AddressP2SH  = AddressType{"base58", ZECAlphabet, "doubleSHA256", "h160", 20, []byte{0x1C, 0xBA}, nil}
+	ZEC_mainnet_t_AddressP2PKH = AddressType{EncodeType: "base58", Alphabet: ZECAlphabet, ChecksumType: "doubleSHA256", HashType: "h160", HashLen: 20, Prefix: []byte{0x1C, 0xB8}}
+	ZEC_mainnet_t_AddressP2SH  = AddressType{EncodeType: "base58", Alphabet: ZECAlphabet, ChecksumType: "doubleSHA256", HashType: "h160", HashLen: 20, ScriptHash: true, Prefix: []byte{0x1C, 0xBD}}
+	ZEC_testnet_t_AddressP2PKH = AddressType{EncodeType: "base58", Alphabet: ZECAlphabet, ChecksumType: "doubleSHA256", HashType: "h160", HashLen: 20, Prefix: []byte{0x1D, 0x25}}
+	ZEC_testnet_t_AddressP2SH  = AddressType{EncodeType: "base58", Alphabet: ZECAlphabet, ChecksumType: "doubleSHA256", HashType: "h160", HashLen: 20, ScriptHash: true, Prefix: []byte{0x1C, 0xBA}}
 
 	//LTC stuff
-	LTC_mainnetAddressP2PKH         = AddressType{"base58", LTCAlphabet, "doubleSHA256", "h160", 20, []byte{0x30}, nil}
-	LTC_mainnetAddressP2SH          = AddressType{"base58", LTCAlphabet, "doubleSHA256", "h160", 20, []byte{0x05}, nil}
-	LTC_mainnetAddressP2SH2         = AddressType{"base58", LTCAlphabet, "doubleSHA256", "h160", 20, []byte{0x32}, nil}
-	LTC_mainnetAddressBech32V0      = AddressType{"bech32", LTCBech32Alphabet, "ltc", "h160", 20, []byte{0}, nil}
-	LTC_mainnetPrivateWIF           = AddressType{"base58", LTCAlphabet, "doubleSHA256", "", 32, []byte{0xB0}, nil}
-	LTC_mainnetPrivateWIFCompressed = AddressType{"base58", LTCAlphabet, "doubleSHA256", "", 32, []byte{0xB0}, []byte{0x01}}
-	LTC_mainnetPublicBIP32          = AddressType{"base58", BTCAlphabet, "doubleSHA256", "", 74, []byte{0x04, 0x88, 0xB2, 0x1E}, nil}
-	LTC_mainnetPrivateBIP32         = AddressType{"base58", BTCAlphabet, "doubleSHA256", "", 74, []byte{0x04, 0x88, 0xAD, 0xE4}, nil}
-	LTC_testnetAddressP2PKH         = AddressType{"base58", LTCAlphabet, "doubleSHA256", "h160", 20, []byte{0x6F}, nil}
-	LTC_testnetAddressP2SH          = AddressType{"base58", LTCAlphabet, "doubleSHA256", "h160", 20, []byte{0xC4}, nil}
-	LTC_testnetAddressP2SH2         = AddressType{"base58", LTCAlphabet, "doubleSHA256", "h160", 20, []byte{0x3A}, nil}
-	LTC_testnetAddressBech32V0      = AddressType{"bech32", LTCBech32Alphabet, "tltc", "h160", 20, []byte{0}, nil}
-	LTC_testnetPrivateWIF           = AddressType{"base58", LTCAlphabet, "doubleSHA256", "", 32, []byte{0xEF}, nil}
-	LTC_testnetPrivateWIFCompressed = AddressType{"base58", LTCAlphabet, "doubleSHA256", "", 32, []byte{0xEF}, []byte{0x01}}
-	LTC_testnetPublicBIP32          = AddressType{"base58", BTCAlphabet, "doubleSHA256", "", 74, []byte{0x04, 0x35, 0x87, 0xCF}, nil}
-	LTC_testnetPrivateBIP32         = AddressType{"base58", BTCAlphabet, "doubleSHA256", "", 74, []byte{0x04, 0x35, 0x83, 0x94}, nil}
-
-	//BCH stuff
-	BCH_mainnetAddressLegacy = AddressType{"base58", BCHLegacyAlphabet, "doubleSHA256", "h160", 20, []byte{0x00}, nil}
-	BCH_mainnetAddressCash   = AddressType{"base32PolyMod", BCHCashAlphabet, "bitcoincash", "h160", 21, nil, nil}
+	LTC_mainnetAddressP2PKH         = AddressType{EncodeType: "base58", Alphabet: LTCAlphabet, ChecksumType: "doubleSHA256", HashType: "h160", HashLen: 20, Prefix: []byte{0x30}}
+	LTC_mainnetAddressP2SH          = AddressType{EncodeType: "base58", Alphabet: LTCAlphabet, ChecksumType: "doubleSHA256", HashType: "h160", HashLen: 20, ScriptHash: true, Prefix: []byte{0x05}}
+	LTC_mainnetAddressP2SH2         = AddressType{EncodeType: "base58", Alphabet: LTCAlphabet, ChecksumType: "doubleSHA256", HashType: "h160", HashLen: 20, ScriptHash: true, Prefix: []byte{0x32}}
+	LTC_mainnetAddressBech32V0      = AddressType{EncodeType: "bech32", Alphabet: LTCBech32Alphabet, ChecksumType: "ltc", HashType: "h160", HashLen: 20, HashLens: []int{20, 32}, Prefix: []byte{0}}
+	LTC_mainnetAddressP2WSH         = AddressType{EncodeType: "bech32", Alphabet: LTCBech32Alphabet, ChecksumType: "ltc", HashLen: 32, Prefix: []byte{0}}
+	LTC_mainnetAddressMWEB          = AddressType{EncodeType: "bech32", Alphabet: LTCBech32Alphabet, ChecksumType: "ltcmweb", HashLen: 66, Prefix: []byte{0}, Bech32Variant: "bech32"}
+	LTC_mainnetPrivateWIF           = AddressType{EncodeType: "base58", Alphabet: LTCAlphabet, ChecksumType: "doubleSHA256", HashLen: 32, Prefix: []byte{0xB0}}
+	LTC_mainnetPrivateWIFCompressed = AddressType{EncodeType: "base58", Alphabet: LTCAlphabet, ChecksumType: "doubleSHA256", HashLen: 32, Prefix: []byte{0xB0}, Suffix: []byte{0x01}}
+	LTC_mainnetPublicBIP32          = AddressType{EncodeType: "base58", Alphabet: BTCAlphabet, ChecksumType: "doubleSHA256", HashLen: 74, Prefix: []byte{0x04, 0x88, 0xB2, 0x1E}}
+	LTC_mainnetPrivateBIP32         = AddressType{EncodeType: "base58", Alphabet: BTCAlphabet, ChecksumType: "doubleSHA256", HashLen: 74, Prefix: []byte{0x04, 0x88, 0xAD, 0xE4}}
+	LTC_testnetAddressP2PKH         = AddressType{EncodeType: "base58", Alphabet: LTCAlphabet, ChecksumType: "doubleSHA256", HashType: "h160", HashLen: 20, Prefix: []byte{0x6F}}
+	LTC_testnetAddressP2SH          = AddressType{EncodeType: "base58", Alphabet: LTCAlphabet, ChecksumType: "doubleSHA256", HashType: "h160", HashLen: 20, ScriptHash: true, Prefix: []byte{0xC4}}
+	LTC_testnetAddressP2SH2         = AddressType{EncodeType: "base58", Alphabet: LTCAlphabet, ChecksumType: "doubleSHA256", HashType: "h160", HashLen: 20, ScriptHash: true, Prefix: []byte{0x3A}}
+	LTC_testnetAddressBech32V0      = AddressType{EncodeType: "bech32", Alphabet: LTCBech32Alphabet, ChecksumType: "tltc", HashType: "h160", HashLen: 20, HashLens: []int{20, 32}, Prefix: []byte{0}}
+	LTC_testnetAddressP2WSH         = AddressType{EncodeType: "bech32", Alphabet: LTCBech32Alphabet, ChecksumType: "tltc", HashLen: 32, Prefix: []byte{0}}
+	LTC_testnetAddressMWEB          = AddressType{EncodeType: "bech32", Alphabet: LTCBech32Alphabet, ChecksumType: "tmweb", HashLen: 66, Prefix: []byte{0}, Bech32Variant: "bech32"}
+	LTC_testnetPrivateWIF           = AddressType{EncodeType: "base58", Alphabet: LTCAlphabet, ChecksumType: "doubleSHA256", HashLen: 32, Prefix: []byte{0xEF}}
+	LTC_testnetPrivateWIFCompressed = AddressType{EncodeType: "base58", Alphabet: LTCAlphabet, ChecksumType: "doubleSHA256", HashLen: 32, Prefix: []byte{0xEF}, Suffix: []byte{0x01}}
+	LTC_testnetPublicBIP32          = AddressType{EncodeType: "base58", Alphabet: BTCAlphabet, ChecksumType: "doubleSHA256", HashLen: 74, Prefix: []byte{0x04, 0x35, 0x87, 0xCF}}
+	LTC_testnetPrivateBIP32         = AddressType{EncodeType: "base58", Alphabet: BTCAlphabet, ChecksumType: "doubleSHA256", HashLen: 74, Prefix: []byte{0x04, 0x35, 0x83, 0x94}}
+
+	//BCH stuff, the legacy addresses share the BTC version bytes
+	BCH_mainnetAddressLegacy = AddressType{EncodeType: "base58", Alphabet: BCHLegacyAlphabet, ChecksumType: "doubleSHA256", HashType: "h160", HashLen: 20, Prefix: []byte{0x00}}
+	BCH_mainnetAddressCash   = AddressType{EncodeType: "base32PolyMod", Alphabet: BCHCashAlphabet, ChecksumType: "bitcoincash", HashType: "h160", HashLen: 21}
+
+	//BCH cashaddr of one type, the one byte Prefix is the type: 0 P2PKH, 1 P2SH and the CashTokens aware 2 P2PKH and 3 P2SH
+	BCH_mainnetAddressCashP2PKH      = AddressType{EncodeType: "base32PolyMod", Alphabet: BCHCashAlphabet, ChecksumType: "bitcoincash", HashType: "h160", HashLen: 20, Prefix: []byte{0x00}}
+	BCH_mainnetAddressCashP2SH       = AddressType{EncodeType: "base32PolyMod", Alphabet: BCHCashAlphabet, ChecksumType: "bitcoincash", HashType: "h160", HashLen: 20, Prefix: []byte{0x01}}
+	BCH_mainnetAddressCashTokenP2PKH = AddressType{EncodeType: "base32PolyMod", Alphabet: BCHCashAlphabet, ChecksumType: "bitcoincash", HashType: "h160", HashLen: 20, Prefix: []byte{0x02}}
+	BCH_mainnetAddressCashTokenP2SH  = AddressType{EncodeType: "base32PolyMod", Alphabet: BCHCashAlphabet, ChecksumType: "bitcoincash", HashType: "h160", HashLen: 20, Prefix: []byte{0x03}}
+	BCH_testnetAddressCashP2PKH      = AddressType{EncodeType: "base32PolyMod", Alphabet: BCHCashAlphabet, ChecksumType: "bchtest", HashType: "h160", HashLen: 20, Prefix: []byte{0x00}}
+	BCH_testnetAddressCashP2SH       = AddressType{EncodeType: "base32PolyMod", Alphabet: BCHCashAlphabet, ChecksumType: "bchtest", HashType: "h160", HashLen: 20, Prefix: []byte{0x01}}
+	BCH_testnetAddressCashTokenP2PKH = AddressType{EncodeType: "base32PolyMod", Alphabet: BCHCashAlphabet, ChecksumType: "bchtest", HashType: "h160", HashLen: 20, Prefix: []byte{0x02}}
+	BCH_testnetAddressCashTokenP2SH  = AddressType{EncodeType: "base32PolyMod", Alphabet: BCHCashAlphabet, ChecksumType: "bchtest", HashType: "h160", HashLen: 20, Prefix: []byte{0x03}}
 
 	//XTZ stuff
-	XTZ_mainnetAddress_tz1   = AddressType{"base58", XTZAlphabet, "doubleSHA256", "blake2b160", 20, []byte{0x06, 0xA1, 0x9F}, nil}
-	XTZ_mainnetAddress_tz2   = AddressType{"base58", XTZAlphabet, "doubleSHA256", "blake2b160", 20, []byte{0x06, 0xA1, 0xA1}, nil}
-	XTZ_mainnetAddress_tz3   = AddressType{"base58", XTZAlphabet, "doubleSHA256", "blake2b160", 20, []byte{0x06, 0xA1, 0xA4}, nil}
-	XTZ_mainnetPublic_edpk   = AddressType{"base58", XTZAlphabet, "doubleSHA256", "", 32, []byte{0x0D, 0x0F, 0x25, 0xD9}, nil}
-	XTZ_mainnetPrivate_edsk  = AddressType{"base58", XTZAlphabet, "doubleSHA256", "", 64, []byte{0x0D, 0x0F, 0x3A, 0x07}, nil}
-	XTZ_mainnetPrivate_edsk2 = AddressType{"base58", XTZAlphabet, "doubleSHA256", "", 32, []byte{0x2B, 0xF6, 0x4E, 0x07}, nil}
-	XTZ_mainnetPrivate_spsk  = AddressType{"base58", XTZAlphabet, "doubleSHA256", "", 32, []byte{0x11, 0xA2, 0xE0, 0xC9}, nil}
-	XTZ_mainnetPrivate_p2sk  = AddressType{"base58", XTZAlphabet, "doubleSHA256", "blake2b160", 32, []byte{0x10, 0x51, 0xEE, 0xBD}, nil}
+	XTZ_mainnetAddress_tz1   = AddressType{EncodeType: "base58", Alphabet: XTZAlphabet, ChecksumType: "doubleSHA256", HashType: "blake2b160", HashLen: 20, Prefix: []byte{0x06, 0xA1, 0x9F}}
+	XTZ_mainnetAddress_tz2   = AddressType{EncodeType: "base58", Alphabet: XTZAlphabet, ChecksumType: "doubleSHA256", HashType: "blake2b160", HashLen: 20, Prefix: []byte{0x06, 0xA1, 0xA1}}
+	XTZ_mainnetAddress_tz3   = AddressType{EncodeType: "base58", Alphabet: XTZAlphabet, ChecksumType: "doubleSHA256", HashType: "blake2b160", HashLen: 20, Prefix: []byte{0x06, 0xA1, 0xA4}}
+	XTZ_mainnetAddress_tz4   = AddressType{EncodeType: "base58", Alphabet: XTZAlphabet, ChecksumType: "doubleSHA256", HashType: "blake2b160", HashLen: 20, Prefix: []byte{0x06, 0xA1, 0xA6}} //BLS public key hash
+	XTZ_mainnetAddress_KT1   = AddressType{EncodeType: "base58", Alphabet: XTZAlphabet, ChecksumType: "doubleSHA256", HashLen: 20, Prefix: []byte{0x02, 0x5A, 0x79}}                         //originated contract
+	XTZ_mainnetPublic_edpk   = AddressType{EncodeType: "base58", Alphabet: XTZAlphabet, ChecksumType: "doubleSHA256", HashLen: 32, Prefix: []byte{0x0D, 0x0F, 0x25, 0xD9}}
+	XTZ_mainnetPrivate_edsk  = AddressType{EncodeType: "base58", Alphabet: XTZAlphabet, ChecksumType: "doubleSHA256", HashLen: 64, Prefix: []byte{0x0D, 0x0F, 0x3A, 0x07}}
+	XTZ_mainnetPrivate_edsk2 = AddressType{EncodeType: "base58", Alphabet: XTZAlphabet, ChecksumType: "doubleSHA256", HashLen: 32, Prefix: []byte{0x2B, 0xF6, 0x4E, 0x07}}
+	XTZ_mainnetPrivate_spsk  = AddressType{EncodeType: "base58", Alphabet: XTZAlphabet, ChecksumType: "doubleSHA256", HashLen: 32, Prefix: []byte{0x11, 0xA2, 0xE0, 0xC9}}
+	XTZ_mainnetPrivate_p2sk  = AddressType{EncodeType: "base58", Alphabet: XTZAlphabet, ChecksumType: "doubleSHA256", HashType: "blake2b160", HashLen: 32, Prefix: []byte{0x10, 0x51, 0xEE, 0xBD}}
 
 	//ETH stuff
-	ETH_mainnetPublicAddress = AddressType{"eip55", "", "", "keccak256", 32, nil, nil}
+	ETH_mainnetPublicAddress = AddressType{EncodeType: "eip55", HashType: "keccak256", HashLen: 32, HashLens: []int{20}}
 
 	//QTUM stuff
-	QTUM_mainnetAddressP2PKH         = AddressType{"base58", QTUMAlphabet, "doubleSHA256", "h160", 20, []byte{0x3A}, nil}
-	QTUM_mainnetAddressP2SH          = AddressType{"base58", QTUMAlphabet, "doubleSHA256", "h160", 20, []byte{0x32}, nil}
-	QTUM_mainnetPrivateWIF           = AddressType{"base58", QTUMAlphabet, "doubleSHA256", "", 32, []byte{0x80}, nil}
-	QTUM_mainnetPrivateWIFCompressed = AddressType{"base58", QTUMAlphabet, "doubleSHA256", "", 32, []byte{0x80}, []byte{0x01}}
-	QTUM_mainnetPublicBIP32          = AddressType{"base58", QTUMAlphabet, "doubleSHA256", "", 74, []byte{0x04, 0x88, 0xB2, 0x1E}, nil}
-	QTUM_mainnetPrivateBIP32         = AddressType{"base58", QTUMAlphabet, "doubleSHA256", "", 74, []byte{0x04, 0x88, 0xAD, 0xE4}, nil}
-	QTUM_testnetAddressP2PKH         = AddressType{"base58", QTUMAlphabet, "doubleSHA256", "h160", 20, []byte{0x78}, nil}
-	QTUM_testnetAddressP2SH          = AddressType{"base58", QTUMAlphabet, "doubleSHA256", "h160", 20, []byte{0x6E}, nil}
-	QTUM_testnetPrivateWIF           = AddressType{"base58", QTUMAlphabet, "doubleSHA256", "", 32, []byte{0xEF}, nil}
-	QTUM_testnetPrivateWIFCompressed = AddressType{"base58", QTUMAlphabet, "doubleSHA256", "", 32, []byte{0xEF}, []byte{0x01}}
-	QTUM_testnetPublicBIP32          = AddressType{"base58", QTUMAlphabet, "doubleSHA256", "", 74, []byte{0x04, 0x35, 0x87, 0xCF}, nil}
-	QTUM_testnetPrivateBIP32         = AddressType{"base58", QTUMAlphabet, "doubleSHA256", "", 74, []byte{0x04, 0x35, 0x83, 0x94}, nil}
+	QTUM_mainnetAddressP2PKH         = AddressType{EncodeType: "base58", Alphabet: QTUMAlphabet, ChecksumType: "doubleSHA256", HashType: "h160", HashLen: 20, Prefix: []byte{0x3A}}
+	QTUM_mainnetAddressP2SH          = AddressType{EncodeType: "base58", Alphabet: QTUMAlphabet, ChecksumType: "doubleSHA256", HashType: "h160", HashLen: 20, ScriptHash: true, Prefix: []byte{0x32}}
+	QTUM_mainnetPrivateWIF           = AddressType{EncodeType: "base58", Alphabet: QTUMAlphabet, ChecksumType: "doubleSHA256", HashLen: 32, Prefix: []byte{0x80}}
+	QTUM_mainnetPrivateWIFCompressed = AddressType{EncodeType: "base58", Alphabet: QTUMAlphabet, ChecksumType: "doubleSHA256", HashLen: 32, Prefix: []byte{0x80}, Suffix: []byte{0x01}}
+	QTUM_mainnetPublicBIP32          = AddressType{EncodeType: "base58", Alphabet: QTUMAlphabet, ChecksumType: "doubleSHA256", HashLen: 74, Prefix: []byte{0x04, 0x88, 0xB2, 0x1E}}
+	QTUM_mainnetPrivateBIP32         = AddressType{EncodeType: "base58", Alphabet: QTUMAlphabet, ChecksumType: "doubleSHA256", HashLen: 74, Prefix: []byte{0x04, 0x88, 0xAD, 0xE4}}
+	QTUM_testnetAddressP2PKH         = AddressType{EncodeType: "base58", Alphabet: QTUMAlphabet, ChecksumType: "doubleSHA256", HashType: "h160", HashLen: 20, Prefix: []byte{0x78}}
+	QTUM_testnetAddressP2SH          = AddressType{EncodeType: "base58", Alphabet: QTUMAlphabet, ChecksumType: "doubleSHA256", HashType: "h160", HashLen: 20, ScriptHash: true, Prefix: []byte{0x6E}}
+	QTUM_testnetPrivateWIF           = AddressType{EncodeType: "base58", Alphabet: QTUMAlphabet, ChecksumType: "doubleSHA256", HashLen: 32, Prefix: []byte{0xEF}}
+	QTUM_testnetPrivateWIFCompressed = AddressType{EncodeType: "base58", Alphabet: QTUMAlphabet, ChecksumType: "doubleSHA256", HashLen: 32, Prefix: []byte{0xEF}, Suffix: []byte{0x01}}
+	QTUM_testnetPublicBIP32          = AddressType{EncodeType: "base58", Alphabet: QTUMAlphabet, ChecksumType: "doubleSHA256", HashLen: 74, Prefix: []byte{0x04, 0x35, 0x87, 0xCF}}
+	QTUM_testnetPrivateBIP32         = AddressType{EncodeType: "base58", Alphabet: QTUMAlphabet, ChecksumType: "doubleSHA256", HashLen: 74, Prefix: []byte{0x04, 0x35, 0x83, 0x94}}
 
 	//DCRD stuff
-	DCRD_mainnetAddressP2PKH      = AddressType{"base58", DCRDAlphabet, "doubleBlake256", "ripemd160", 20, []byte{0x07, 0x3f}, nil} //PubKeyHashAddrID, stars with Ds
-	DCRD_mainnetAddressP2PK       = AddressType{"base58", DCRDAlphabet, "doubleBlake256", "ripemd160", 20, []byte{0x13, 0x86}, nil} //PubKeyAddrID,stars with Dk
-	DCRD_mainnetAddressPKHEdwards = AddressType{"base58", DCRDAlphabet, "doubleBlake256", "ripemd160", 20, []byte{0x07, 0x1f}, nil} //PKHEdwardsAddrID,starts with De
-	DCRD_mainnetAddressPKHSchnorr = AddressType{"base58", DCRDAlphabet, "doubleBlake256", "ripemd160", 20, []byte{0x07, 0x01}, nil} //PKHSchnorrAddrID,starts with DS
-	DCRD_mainnetAddressP2SH       = AddressType{"base58", DCRDAlphabet, "doubleBlake256", "ripemd160", 20, []byte{0x07, 0x1a}, nil} //ScriptHashAddrID,starts with Dc
-	DCRD_mainnetAddressPrivate    = AddressType{"base58", DCRDAlphabet, "doubleBlake256", "ripemd160", 20, []byte{0x22, 0xde}, nil} // PrivateKeyID, starts with Pm
-
-	DCRD_testnetAddressP2PKH        = AddressType{"base58", DCRDAlphabet, "doubleBlake256", "ripemd160", 20, []byte{0x0f, 0x21}, nil} //PubKeyHashAddrID,starts with Ts
-	DCRD_testnetAddressP2PK         = AddressType{"base58", DCRDAlphabet, "doubleBlake256", "ripemd160", 20, []byte{0x28, 0xf7}, nil} //PubKeyAddrID, starts with Tk
-	DCRD_testnetAddressPKHEdwards   = AddressType{"base58", DCRDAlphabet, "doubleBlake256", "ripemd160", 20, []byte{0x0f, 0x01}, nil} //PKHEdwardsAddrID,starts with Te
-	DCRD_testnetAddressP2PKHSchnorr = AddressType{"base58", DCRDAlphabet, "doubleBlake256", "ripemd160", 20, []byte{0x0e, 0xe3}, nil} //PKHSchnorrAddrID,starts with TS
-	DCRD_testnetAddressP2SH         = AddressType{"base58", DCRDAlphabet, "doubleBlake256", "ripemd160", 20, []byte{0x0e, 0xfc}, nil} //ScriptHashAddrID,starts with Tc
-	DCRD_testnetAddressPrivate      = AddressType{"base58", DCRDAlphabet, "doubleBlake256", "ripemd160", 20, []byte{0x23, 0x0e}, nil} //PrivateKeyID,starts with Pt
-
-	DCRD_simnetAddressP2PKH      = AddressType{"base58", DCRDAlphabet, "doubleBlake256", "ripemd160", 20, []byte{0x0e, 0x91}, nil} //PubKeyHashAddrID,starts with Ss
-	DCRD_simnetAddressP2PK       = AddressType{"base58", DCRDAlphabet, "doubleBlake256", "ripemd160", 20, []byte{0x27, 0x6f}, nil} //PubKeyAddrID,starts with Sk
-	DCRD_simnetAddressPKHEdwards = AddressType{"base58", DCRDAlphabet, "doubleBlake256", "ripemd160", 20, []byte{0x0e, 0x71}, nil} //PKHEdwardsAddrID,starts with Se
-	DCRD_simnetAddressPKHSchnorr = AddressType{"base58", DCRDAlphabet, "doubleBlake256", "ripemd160", 20, []byte{0x0e, 0x53}, nil} //PKHSchnorrAddrID,starts with SS
-	DCRD_simnetAddressP2SH       = AddressType{"base58", DCRDAlphabet, "doubleBlake256", "ripemd160", 20, []byte{0x0e, 0x6c}, nil} //ScriptHashAddrID,starts with Sc
-	DCRD_simnetAddressPrivate    = AddressType{"base58", DCRDAlphabet, "doubleBlake256", "ripemd160", 20, []byte{0x23, 0x07}, nil} //PrivateKeyID, starts with Ps
+	DCRD_mainnetAddressP2PKH      = AddressType{EncodeType: "base58", Alphabet: DCRDAlphabet, ChecksumType: "doubleBlake256", HashType: "ripemd160", HashLen: 20, Prefix: []byte{0x07, 0x3f}}                   //PubKeyHashAddrID, stars with Ds
+	DCRD_mainnetAddressP2PK       = AddressType{EncodeType: "base58", Alphabet: DCRDAlphabet, ChecksumType: "doubleBlake256", HashType: "ripemd160", HashLen: 20, Prefix: []byte{0x13, 0x86}}                   //PubKeyAddrID,stars with Dk
+	DCRD_mainnetAddressPKHEdwards = AddressType{EncodeType: "base58", Alphabet: DCRDAlphabet, ChecksumType: "doubleBlake256", HashType: "ripemd160", HashLen: 20, Prefix: []byte{0x07, 0x1f}}                   //PKHEdwardsAddrID,starts with De
+	DCRD_mainnetAddressPKHSchnorr = AddressType{EncodeType: "base58", Alphabet: DCRDAlphabet, ChecksumType: "doubleBlake256", HashType: "ripemd160", HashLen: 20, Prefix: []byte{0x07, 0x01}}                   //PKHSchnorrAddrID,starts with DS
+	DCRD_mainnetAddressP2SH       = AddressType{EncodeType: "base58", Alphabet: DCRDAlphabet, ChecksumType: "doubleBlake256", HashType: "ripemd160", HashLen: 20, ScriptHash: true, Prefix: []byte{0x07, 0x1a}} //ScriptHashAddrID,starts with Dc
+	DCRD_mainnetAddressPrivate    = AddressType{EncodeType: "base58", Alphabet: DCRDAlphabet, ChecksumType: "doubleBlake256", HashType: "ripemd160", HashLen: 20, Prefix: []byte{0x22, 0xde}}                   // PrivateKeyID, starts with Pm
+
+	DCRD_testnetAddressP2PKH        = AddressType{EncodeType: "base58", Alphabet: DCRDAlphabet, ChecksumType: "doubleBlake256", HashType: "ripemd160", HashLen: 20, Prefix: []byte{0x0f, 0x21}}                   //PubKeyHashAddrID,starts with Ts
+	DCRD_testnetAddressP2PK         = AddressType{EncodeType: "base58", Alphabet: DCRDAlphabet, ChecksumType: "doubleBlake256", HashType: "ripemd160", HashLen: 20, Prefix: []byte{0x28, 0xf7}}                   //PubKeyAddrID, starts with Tk
+	DCRD_testnetAddressPKHEdwards   = AddressType{EncodeType: "base58", Alphabet: DCRDAlphabet, ChecksumType: "doubleBlake256", HashType: "ripemd160", HashLen: 20, Prefix: []byte{0x0f, 0x01}}                   //PKHEdwardsAddrID,starts with Te
+	DCRD_testnetAddressP2PKHSchnorr = AddressType{EncodeType: "base58", Alphabet: DCRDAlphabet, ChecksumType: "doubleBlake256", HashType: "ripemd160", HashLen: 20, Prefix: []byte{0x0e, 0xe3}}                   //PKHSchnorrAddrID,starts with TS
+	DCRD_testnetAddressP2SH         = AddressType{EncodeType: "base58", Alphabet: DCRDAlphabet, ChecksumType: "doubleBlake256", HashType: "ripemd160", HashLen: 20, ScriptHash: true, Prefix: []byte{0x0e, 0xfc}} //ScriptHashAddrID,starts with Tc
+	DCRD_testnetAddressPrivate      = AddressType{EncodeType: "base58", Alphabet: DCRDAlphabet, ChecksumType: "doubleBlake256", HashType: "ripemd160", HashLen: 20, Prefix: []byte{0x23, 0x0e}}                   //PrivateKeyID,starts with Pt
+
+	DCRD_simnetAddressP2PKH      = AddressType{EncodeType: "base58", Alphabet: DCRDAlphabet, ChecksumType: "doubleBlake256", HashType: "ripemd160", HashLen: 20, Prefix: []byte{0x0e, 0x91}}                   //PubKeyHashAddrID,starts with Ss
+	DCRD_simnetAddressP2PK       = AddressType{EncodeType: "base58", Alphabet: DCRDAlphabet, ChecksumType: "doubleBlake256", HashType: "ripemd160", HashLen: 20, Prefix: []byte{0x27, 0x6f}}                   //PubKeyAddrID,starts with Sk
+	DCRD_simnetAddressPKHEdwards = AddressType{EncodeType: "base58", Alphabet: DCRDAlphabet, ChecksumType: "doubleBlake256", HashType: "ripemd160", HashLen: 20, Prefix: []byte{0x0e, 0x71}}                   //PKHEdwardsAddrID,starts with Se
+	DCRD_simnetAddressPKHSchnorr = AddressType{EncodeType: "base58", Alphabet: DCRDAlphabet, ChecksumType: "doubleBlake256", HashType: "ripemd160", HashLen: 20, Prefix: []byte{0x0e, 0x53}}                   //PKHSchnorrAddrID,starts with SS
+	DCRD_simnetAddressP2SH       = AddressType{EncodeType: "base58", Alphabet: DCRDAlphabet, ChecksumType: "doubleBlake256", HashType: "ripemd160", HashLen: 20, ScriptHash: true, Prefix: []byte{0x0e, 0x6c}} //ScriptHashAddrID,starts with Sc
+	DCRD_simnetAddressPrivate    = AddressType{EncodeType: "base58", Alphabet: DCRDAlphabet, ChecksumType: "doubleBlake256", HashType: "ripemd160", HashLen: 20, Prefix: []byte{0x23, 0x07}}                   //PrivateKeyID, starts with Ps
 
 	//Nebulas stuff
-	NAS_AccountAddress       = AddressType{"base58", NASAlphabet, "sha3_256", "sha3_256_ripemd160", 20, []byte{0x19, 0x57}, nil}
-	NAS_SmartContractAddress = AddressType{"base58", NASAlphabet, "sha3_256", "sha3_256_ripemd160", 20, []byte{0x19, 0x58}, nil}
+	NAS_AccountAddress       = AddressType{EncodeType: "base58", Alphabet: NASAlphabet, ChecksumType: "sha3_256", HashType: "sha3_256_ripemd160", HashLen: 20, Prefix: []byte{0x19, 0x57}}
+	NAS_SmartContractAddress = AddressType{EncodeType: "base58", Alphabet: NASAlphabet, ChecksumType: "sha3_256", HashType: "sha3_256_ripemd160", HashLen: 20, Prefix: []byte{0x19, 0x58}}
 
 	//TRON stuff
-	TRON_mainnetAddress = AddressType{"base58", TRONAlphabet, "doubleSHA256", "keccak256_last_twenty", 20, []byte{0x41}, nil}
-	TRON_testnetAddress = AddressType{"base58", TRONAlphabet, "doubleSHA256", "keccak256_last_twenty", 20, []byte{0xa0}, nil}
+	TRON_mainnetAddress = AddressType{EncodeType: "base58", Alphabet: TRONAlphabet, ChecksumType: "doubleSHA256", HashType: "keccak256_last_twenty", HashLen: 20, Prefix: []byte{0x41}}
+	TRON_testnetAddress = AddressType{EncodeType: "base58", Alphabet: TRONAlphabet, ChecksumType: "doubleSHA256", HashType: "keccak256_last_twenty", HashLen: 20, Prefix: []byte{0xa0}}
 	//ICX stuff
-	ICX_walletAddress = AddressType{"ICX", "", "hx", "sha3_256_last_twenty", 20, nil, nil}
+	ICX_walletAddress = AddressType{EncodeType: "ICX", ChecksumType: "hx", HashType: "sha3_256_last_twenty", HashLen: 20}
 
 	//VSYS stuff
-	VSYS_mainnetAddress = AddressType{"base58", VSYSAlphabet, "blake2b_and_keccak256_first_twenty", "blake2b_and_keccak256_first_twenty", 20, []byte{0x05, 0x4D}, nil}
-	VSYS_testnetAddress = AddressType{"base58", VSYSAlphabet, "blake2b_and_keccak256_first_twenty", "blake2b_and_keccak256_first_twenty", 20, []byte{0x05, 0x54}, nil}
+	VSYS_mainnetAddress = AddressType{EncodeType: "base58", Alphabet: VSYSAlphabet, ChecksumType: "blake2b_and_keccak256_first_twenty", HashType: "blake2b_and_keccak256_first_twenty", HashLen: 20, Prefix: []byte{0x05, 0x4D}}
+	VSYS_testnetAddress = AddressType{EncodeType: "base58", Alphabet: VSYSAlphabet, ChecksumType: "blake2b_and_keccak256_first_twenty", HashType: "blake2b_and_keccak256_first_twenty", HashLen: 20, Prefix: []byte{0x05, 0x54}}
 
 	//EOS stuff
-	EOS_mainnetPublic               = AddressType{"eos", BTCAlphabet, "ripemd160", "", 33, []byte(EOSPublicKeyPrefixCompat), nil}
-	EOS_mainnetPrivateWIF           = AddressType{"base58", BTCAlphabet, "doubleSHA256", "", 32, []byte{0x80}, nil}
-	EOS_mainnetPrivateWIFCompressed = AddressType{"base58", BTCAlphabet, "doubleSHA256", "", 32, []byte{0x80}, []byte{0x01}}
+	EOS_mainnetPublic               = AddressType{EncodeType: "eos", Alphabet: BTCAlphabet, ChecksumType: "ripemd160", HashLen: 33, Prefix: []byte(EOSPublicKeyPrefixCompat)}
+	EOS_mainnetPrivateWIF           = AddressType{EncodeType: "base58", Alphabet: BTCAlphabet, ChecksumType: "doubleSHA256", HashLen: 32, Prefix: []byte{0x80}}
+	EOS_mainnetPrivateWIFCompressed = AddressType{EncodeType: "base58", Alphabet: BTCAlphabet, ChecksumType: "doubleSHA256", HashLen: 32, Prefix: []byte{0x80}, Suffix: []byte{0x01}}
 
 	//AE stuff
-	AE_mainnetAddress = AddressType{"aeternity", BTCAlphabet, "doubleSHA256", "", 32, []byte(AEPrefixAccountPubkey), nil}
+	AE_mainnetAddress = AddressType{EncodeType: "aeternity", Alphabet: BTCAlphabet, ChecksumType: "doubleSHA256", HashLen: 32, Prefix: []byte(AEPrefixAccountPubkey)}
 
 	//ATOM stuff
-	ATOM_mainnetAddress = AddressType{"bech32", ATOMBech32Alphabet, "cosmos", "h160", 20, nil, nil}
-	ATOM_testnetAddress = AddressType{"bech32", ATOMBech32Alphabet, "cosmos", "h160", 20, nil, nil}
+	ATOM_mainnetAddress = AddressType{EncodeType: "bech32", Alphabet: ATOMBech32Alphabet, ChecksumType: "cosmos", HashType: "h160", HashLen: 20, HashLens: []int{20, 32}}
+	ATOM_testnetAddress = AddressType{EncodeType: "bech32", Alphabet: ATOMBech32Alphabet, ChecksumType: "cosmos", HashType: "h160", HashLen: 20, HashLens: []int{20, 32}}
+
+	//LUNA stuff, Terra and Terra Classic share the cosmos style addresses
+	LUNA_mainnetAddress        = AddressType{EncodeType: "bech32", Alphabet: ATOMBech32Alphabet, ChecksumType: "terra", HashType: "h160", HashLen: 20}
+	LUNA_mainnetValoperAddress = AddressType{EncodeType: "bech32", Alphabet: ATOMBech32Alphabet, ChecksumType: "terravaloper", HashType: "h160", HashLen: 20}
 
 	//ELA stuff
-	ELA_Address = AddressType{"base58", BTCAlphabet, "doubleSHA256", "h160", 20, []byte{0x21}, nil}
+	ELA_Address = AddressType{EncodeType: "base58", Alphabet: BTCAlphabet, ChecksumType: "doubleSHA256", HashType: "h160", HashLen: 20, Prefix: []byte{0x21}}
 
 	//WICC stuff
-	WICC_mainnetAddressP2PKH = AddressType{"base58", BTCAlphabet, "doubleSHA256", "h160", 20, []byte{0x49}, nil}
-	WICC_testnetAddressP2PKH = AddressType{"base58", BTCAlphabet, "doubleSHA256", "h160", 20, []byte{0x87}, nil}
+	WICC_mainnetAddressP2PKH = AddressType{EncodeType: "base58", Alphabet: BTCAlphabet, ChecksumType: "doubleSHA256", HashType: "h160", HashLen: 20, Prefix: []byte{0x49}}
+	WICC_testnetAddressP2PKH = AddressType{EncodeType: "base58", Alphabet: BTCAlphabet, ChecksumType: "doubleSHA256", HashType: "h160", HashLen: 20, Prefix: []byte{0x87}}
 
 	//TV stuff
-	TV_mainnetAddress = AddressType{"base58", VSYSAlphabet, "blake2b_and_keccak256_first_twenty", "blake2b_and_keccak256_first_twenty", 20, []byte{0x1D, 0x3B}, nil}
-	TV_testnetAddress = AddressType{"base58", VSYSAlphabet, "blake2b_and_keccak256_first_twenty", "blake2b_and_keccak256_first_twenty", 20, []byte{0x1D, 0x54}, nil}
+	TV_mainnetAddress = AddressType{EncodeType: "base58", Alphabet: VSYSAlphabet, ChecksumType: "blake2b_and_keccak256_first_twenty", HashType: "blake2b_and_keccak256_first_twenty", HashLen: 20, Prefix: []byte{0x1D, 0x3B}}
+	TV_testnetAddress = AddressType{EncodeType: "base58", Alphabet: VSYSAlphabet, ChecksumType: "blake2b_and_keccak256_first_twenty", HashType: "blake2b_and_keccak256_first_twenty", HashLen: 20, Prefix: []byte{0x1D, 0x54}}
 
 	//HC stuff
-	HC_mainnetPublicAddress     = AddressType{"base58", HCAlphabet, "doubleBlake256", "h160", 20, []byte{0x09, 0x7F}, nil}
-	HC_mainnetAddressP2PK       = AddressType{"base58", HCAlphabet, "doubleBlake256", "h160", 20, []byte{0x19, 0xa4}, nil} //PubKeyAddrID,stars with Hk
-	HC_mainnetAddressP2PKBliss  = AddressType{"base58", HCAlphabet, "doubleBlake256", "h160", 20, []byte{0x07, 0xc3}, nil} //PubKeyAddrID,stars with Hk
-	HC_mainnetAddressP2PKH      = AddressType{"base58", HCAlphabet, "doubleBlake256", "h160", 20, []byte{0x09, 0x7f}, nil} //PubKeyHashAddrID, stars with Hs
-	HC_mainnetAddressPKHEdwards = AddressType{"base58", HCAlphabet, "doubleBlake256", "h160", 20, []byte{0x09, 0x60}, nil} //PKHEdwardsAddrID,starts with He
-	HC_mainnetAddressPKHSchnorr = AddressType{"base58", HCAlphabet, "doubleBlake256", "h160", 20, []byte{0x09, 0x41}, nil} //PKHSchnorrAddrID,starts with HS
-	HC_mainnetAddressPKHBliss   = AddressType{"base58", HCAlphabet, "doubleBlake256", "h160", 20, []byte{0x09, 0x58}, nil} //PKHSchnorrAddrID,starts with Hb
-	HC_mainnetAddressP2SH       = AddressType{"base58", HCAlphabet, "doubleBlake256", "h160", 20, []byte{0x09, 0x5a}, nil} //ScriptHashAddrID,starts with Hc
-	HC_mainnetAddressPrivate    = AddressType{"base58", HCAlphabet, "doubleBlake256", "h160", 20, []byte{0x19, 0xab}, nil} // PrivateKeyID, starts with Hm
-
-	HC_testnetAddressP2PK         = AddressType{"base58", HCAlphabet, "doubleBlake256", "h160", 20, []byte{0x28, 0xf7}, nil} //PubKeyAddrID, starts with Tk
-	HC_testnetAddressP2PKBliss    = AddressType{"base58", HCAlphabet, "doubleBlake256", "h160", 20, []byte{0x0c, 0x66}, nil} //PubKeyAddrID, starts with Tk
-	HC_testnetAddressP2PKH        = AddressType{"base58", HCAlphabet, "doubleBlake256", "h160", 20, []byte{0x0f, 0x21}, nil} //PubKeyHashAddrID,starts with Ts
-	HC_testnetAddressPKHEdwards   = AddressType{"base58", HCAlphabet, "doubleBlake256", "h160", 20, []byte{0x0f, 0x01}, nil} //PKHEdwardsAddrID,starts with Te
-	HC_testnetAddressP2PKHSchnorr = AddressType{"base58", HCAlphabet, "doubleBlake256", "h160", 20, []byte{0x0e, 0xe3}, nil} //PKHSchnorrAddrID,starts with TS
-	HC_testnetAddressPKHBliss     = AddressType{"base58", HCAlphabet, "doubleBlake256", "h160", 20, []byte{0x0e, 0xf9}, nil} //PKHSchnorrAddrID,starts with Tb
-	HC_testnetAddressP2SH         = AddressType{"base58", HCAlphabet, "doubleBlake256", "h160", 20, []byte{0x0e, 0xfc}, nil} //ScriptHashAddrID,starts with Tc
-	HC_testnetAddressPrivate      = AddressType{"base58", HCAlphabet, "doubleBlake256", "h160", 20, []byte{0x23, 0x0e}, nil} //PrivateKeyID,starts with Pt
-
-	HC_simnetAddressP2PK       = AddressType{"base58", HCAlphabet, "doubleBlake256", "h160", 20, []byte{0x27, 0x6f}, nil} //PubKeyAddrID,starts with Sk
-	HC_simnetAddressP2PKBliss  = AddressType{"base58", HCAlphabet, "doubleBlake256", "h160", 20, []byte{0x0b, 0xef}, nil} //PubKeyAddrID,starts with Sk
-	HC_simnetAddressP2PKH      = AddressType{"base58", HCAlphabet, "doubleBlake256", "h160", 20, []byte{0x0e, 0x91}, nil} //PubKeyHashAddrID,starts with Ss
-	HC_simnetAddressPKHEdwards = AddressType{"base58", HCAlphabet, "doubleBlake256", "h160", 20, []byte{0x0e, 0x71}, nil} //PKHEdwardsAddrID,starts with Se
-	HC_simnetAddressPKHSchnorr = AddressType{"base58", HCAlphabet, "doubleBlake256", "h160", 20, []byte{0x0e, 0x53}, nil} //PKHSchnorrAddrID,starts with SS
-	HC_simnetAddressPKHBliss   = AddressType{"base58", HCAlphabet, "doubleBlake256", "h160", 20, []byte{0x0e, 0x69}, nil} //PKHBlissAddrID,starts with Sb
-	HC_simnetAddressP2SH       = AddressType{"base58", HCAlphabet, "doubleBlake256", "h160", 20, []byte{0x0e, 0x6c}, nil} //ScriptHashAddrID,starts with Sc
-	HC_simnetAddressPrivate    = AddressType{"base58", HCAlphabet, "doubleBlake256", "h160", 20, []byte{0x23, 0x07}, nil} //PrivateKeyID, starts with Ps
-
-
-	BNB_mainnetAddress = AddressType{"bech32", BTCBech32Alphabet, "bnb", "h160", 20, nil, nil}
-
-	BSV_mainnetAddressP2PKH = AddressType{"base58", BTCAlphabet, "doubleSHA256", "h160", 20, []byte{0x00}, nil}
-	BSV_mainnetAddressP2SH = AddressType{"base58", BTCAlphabet, "doubleSHA256", "h160", 20, []byte{0x05}, nil}
-
-	EVA_mainnetAddress = AddressType{"bech32", ATOMBech32Alphabet, "eva", "h160", 20, nil, nil}
-	EVA_testnetAddress = AddressType{"bech32", ATOMBech32Alphabet, "eva", "h160", 20, nil, nil}
+	HC_mainnetPublicAddress     = AddressType{EncodeType: "base58", Alphabet: HCAlphabet, ChecksumType: "doubleBlake256", HashType: "h160", HashLen: 20, Prefix: []byte{0x09, 0x7F}}
+	HC_mainnetAddressP2PK       = AddressType{EncodeType: "base58", Alphabet: HCAlphabet, ChecksumType: "doubleBlake256", HashType: "h160", HashLen: 20, Prefix: []byte{0x19, 0xa4}}                   //PubKeyAddrID,stars with Hk
+	HC_mainnetAddressP2PKBliss  = AddressType{EncodeType: "base58", Alphabet: HCAlphabet, ChecksumType: "doubleBlake256", HashType: "h160", HashLen: 20, Prefix: []byte{0x07, 0xc3}}                   //PubKeyAddrID,stars with Hk
+	HC_mainnetAddressP2PKH      = AddressType{EncodeType: "base58", Alphabet: HCAlphabet, ChecksumType: "doubleBlake256", HashType: "h160", HashLen: 20, Prefix: []byte{0x09, 0x7f}}                   //PubKeyHashAddrID, stars with Hs
+	HC_mainnetAddressPKHEdwards = AddressType{EncodeType: "base58", Alphabet: HCAlphabet, ChecksumType: "doubleBlake256", HashType: "h160", HashLen: 20, Prefix: []byte{0x09, 0x60}}                   //PKHEdwardsAddrID,starts with He
+	HC_mainnetAddressPKHSchnorr = AddressType{EncodeType: "base58", Alphabet: HCAlphabet, ChecksumType: "doubleBlake256", HashType: "h160", HashLen: 20, Prefix: []byte{0x09, 0x41}}                   //PKHSchnorrAddrID,starts with HS
+	HC_mainnetAddressPKHBliss   = AddressType{EncodeType: "base58", Alphabet: HCAlphabet, ChecksumType: "doubleBlake256", HashType: "h160", HashLen: 20, Prefix: []byte{0x09, 0x58}}                   //PKHSchnorrAddrID,starts with Hb
+	HC_mainnetAddressP2SH       = AddressType{EncodeType: "base58", Alphabet: HCAlphabet, ChecksumType: "doubleBlake256", HashType: "h160", HashLen: 20, ScriptHash: true, Prefix: []byte{0x09, 0x5a}} //ScriptHashAddrID,starts with Hc
+	HC_mainnetAddressPrivate    = AddressType{EncodeType: "base58", Alphabet: HCAlphabet, ChecksumType: "doubleBlake256", HashType: "h160", HashLen: 20, Prefix: []byte{0x19, 0xab}}                   // PrivateKeyID, starts with Hm
+
+	HC_testnetAddressP2PK         = AddressType{EncodeType: "base58", Alphabet: HCAlphabet, ChecksumType: "doubleBlake256", HashType: "h160", HashLen: 20, Prefix: []byte{0x28, 0xf7}}                   //PubKeyAddrID, starts with Tk
+	HC_testnetAddressP2PKBliss    = AddressType{EncodeType: "base58", Alphabet: HCAlphabet, ChecksumType: "doubleBlake256", HashType: "h160", HashLen: 20, Prefix: []byte{0x0c, 0x66}}                   //PubKeyAddrID, starts with Tk
+	HC_testnetAddressP2PKH        = AddressType{EncodeType: "base58", Alphabet: HCAlphabet, ChecksumType: "doubleBlake256", HashType: "h160", HashLen: 20, Prefix: []byte{0x0f, 0x21}}                   //PubKeyHashAddrID,starts with Ts
+	HC_testnetAddressPKHEdwards   = AddressType{EncodeType: "base58", Alphabet: HCAlphabet, ChecksumType: "doubleBlake256", HashType: "h160", HashLen: 20, Prefix: []byte{0x0f, 0x01}}                   //PKHEdwardsAddrID,starts with Te
+	HC_testnetAddressP2PKHSchnorr = AddressType{EncodeType: "base58", Alphabet: HCAlphabet, ChecksumType: "doubleBlake256", HashType: "h160", HashLen: 20, Prefix: []byte{0x0e, 0xe3}}                   //PKHSchnorrAddrID,starts with TS
+	HC_testnetAddressPKHBliss     = AddressType{EncodeType: "base58", Alphabet: HCAlphabet, ChecksumType: "doubleBlake256", HashType: "h160", HashLen: 20, Prefix: []byte{0x0e, 0xf9}}                   //PKHSchnorrAddrID,starts with Tb
+	HC_testnetAddressP2SH         = AddressType{EncodeType: "base58", Alphabet: HCAlphabet, ChecksumType: "doubleBlake256", HashType: "h160", HashLen: 20, ScriptHash: true, Prefix: []byte{0x0e, 0xfc}} //ScriptHashAddrID,starts with Tc
+	HC_testnetAddressPrivate      = AddressType{EncodeType: "base58", Alphabet: HCAlphabet, ChecksumType: "doubleBlake256", HashType: "h160", HashLen: 20, Prefix: []byte{0x23, 0x0e}}                   //PrivateKeyID,starts with Pt
+
+	HC_simnetAddressP2PK       = AddressType{EncodeType: "base58", Alphabet: HCAlphabet, ChecksumType: "doubleBlake256", HashType: "h160", HashLen: 20, Prefix: []byte{0x27, 0x6f}}                   //PubKeyAddrID,starts with Sk
+	HC_simnetAddressP2PKBliss  = AddressType{EncodeType: "base58", Alphabet: HCAlphabet, ChecksumType: "doubleBlake256", HashType: "h160", HashLen: 20, Prefix: []byte{0x0b, 0xef}}                   //PubKeyAddrID,starts with Sk
+	HC_simnetAddressP2PKH      = AddressType{EncodeType: "base58", Alphabet: HCAlphabet, ChecksumType: "doubleBlake256", HashType: "h160", HashLen: 20, Prefix: []byte{0x0e, 0x91}}                   //PubKeyHashAddrID,starts with Ss
+	HC_simnetAddressPKHEdwards = AddressType{EncodeType: "base58", Alphabet: HCAlphabet, ChecksumType: "doubleBlake256", HashType: "h160", HashLen: 20, Prefix: []byte{0x0e, 0x71}}                   //PKHEdwardsAddrID,starts with Se
+	HC_simnetAddressPKHSchnorr = AddressType{EncodeType: "base58", Alphabet: HCAlphabet, ChecksumType: "doubleBlake256", HashType: "h160", HashLen: 20, Prefix: []byte{0x0e, 0x53}}                   //PKHSchnorrAddrID,starts with SS
+	HC_simnetAddressPKHBliss   = AddressType{EncodeType: "base58", Alphabet: HCAlphabet, ChecksumType: "doubleBlake256", HashType: "h160", HashLen: 20, Prefix: []byte{0x0e, 0x69}}                   //PKHBlissAddrID,starts with Sb
+	HC_simnetAddressP2SH       = AddressType{EncodeType: "base58", Alphabet: HCAlphabet, ChecksumType: "doubleBlake256", HashType: "h160", HashLen: 20, ScriptHash: true, Prefix: []byte{0x0e, 0x6c}} //ScriptHashAddrID,starts with Sc
+	HC_simnetAddressPrivate    = AddressType{EncodeType: "base58", Alphabet: HCAlphabet, ChecksumType: "doubleBlake256", HashType: "h160", HashLen: 20, Prefix: []byte{0x23, 0x07}}                   //PrivateKeyID, starts with Ps
+
+	//BGL stuff, segwit v0 uses bech32m
+	BGL_mainnetAddressBech32V0 = AddressType{EncodeType: "bech32", Alphabet: BTCBech32Alphabet, ChecksumType: "bgl", HashType: "h160", HashLen: 20, Prefix: []byte{0}, Bech32Variant: "bech32m"}
+
+	BNB_mainnetAddress = AddressType{EncodeType: "bech32", Alphabet: BTCBech32Alphabet, ChecksumType: "bnb", HashType: "h160", HashLen: 20, HashLens: []int{20, 32}}
+
+	//BSV stuff, legacy addresses share the BTC version bytes, so the chain can not be told from the address itself
+	BSV_mainnetAddressP2PKH = AddressType{EncodeType: "base58", Alphabet: BTCAlphabet, ChecksumType: "doubleSHA256", HashType: "h160", HashLen: 20, Prefix: []byte{0x00}}
+	BSV_mainnetAddressP2SH  = AddressType{EncodeType: "base58", Alphabet: BTCAlphabet, ChecksumType: "doubleSHA256", HashType: "h160", HashLen: 20, ScriptHash: true, Prefix: []byte{0x05}}
+	BSV_testnetAddressP2PKH = AddressType{EncodeType: "base58", Alphabet: BTCAlphabet, ChecksumType: "doubleSHA256", HashType: "h160", HashLen: 20, Prefix: []byte{0x6F}}
+	BSV_testnetAddressP2SH  = AddressType{EncodeType: "base58", Alphabet: BTCAlphabet, ChecksumType: "doubleSHA256", HashType: "h160", HashLen: 20, ScriptHash: true, Prefix: []byte{0xC4}}
+
+	EVA_mainnetAddress = AddressType{EncodeType: "bech32", Alphabet: ATOMBech32Alphabet, ChecksumType: "eva", HashType: "h160", HashLen: 20, HashLens: []int{20, 32}}
+	EVA_testnetAddress = AddressType{EncodeType: "bech32", Alphabet: ATOMBech32Alphabet, ChecksumType: "eva", HashType: "h160", HashLen: 20, HashLens: []int{20, 32}}
+
+	//FIL stuff, the prefix is the network letter and the protocol digit, f1/t1 carry the blake2b-160 of a secp256k1 public key, f3/t3 a raw 48-byte BLS public key
+	FIL_mainnetAddressSecp256k1 = AddressType{EncodeType: "filecoin", Alphabet: FILAlphabet, ChecksumType: "blake2b32", HashType: "blake2b160", HashLen: 20, Prefix: []byte("f1")}
+	FIL_testnetAddressSecp256k1 = AddressType{EncodeType: "filecoin", Alphabet: FILAlphabet, ChecksumType: "blake2b32", HashType: "blake2b160", HashLen: 20, Prefix: []byte("t1")}
+	FIL_mainnetAddressBLS       = AddressType{EncodeType: "filecoin", Alphabet: FILAlphabet, ChecksumType: "blake2b32", HashLen: 48, Prefix: []byte("f3")}
+	FIL_testnetAddressBLS       = AddressType{EncodeType: "filecoin", Alphabet: FILAlphabet, ChecksumType: "blake2b32", HashLen: 48, Prefix: []byte("t3")}
+
+	//ZIL stuff, the bech32 of the last 20 bytes of sha256(compressed public key), without a witness version
+	ZIL_mainnetAddress = AddressType{EncodeType: "bech32", Alphabet: BTCBech32Alphabet, ChecksumType: "zil", HashType: "sha256_last_twenty", HashLen: 20}
+
+	//ONE stuff, the bech32 of the 20-byte ethereum style address
+	ONE_mainnetAddress = AddressType{EncodeType: "bech32", Alphabet: BTCBech32Alphabet, ChecksumType: "one", HashType: "keccak256_last_twenty", HashLen: 20}
+
+	//IOTA stuff, the bech32 of the address type byte and the blake2b-256 of the ed25519 public key, shimmer shares the format
+	IOTA_mainnetAddressEd25519 = AddressType{EncodeType: "iota", Alphabet: BTCBech32Alphabet, ChecksumType: "iota", HashType: "blake2b256", HashLen: 32, Prefix: []byte{0x00}}
+	IOTA_testnetAddressEd25519 = AddressType{EncodeType: "iota", Alphabet: BTCBech32Alphabet, ChecksumType: "atoi", HashType: "blake2b256", HashLen: 32, Prefix: []byte{0x00}}
+	SMR_mainnetAddressEd25519  = AddressType{EncodeType: "iota", Alphabet: BTCBech32Alphabet, ChecksumType: "smr", HashType: "blake2b256", HashLen: 32, Prefix: []byte{0x00}}
+	SMR_testnetAddressEd25519  = AddressType{EncodeType: "iota", Alphabet: BTCBech32Alphabet, ChecksumType: "rms", HashType: "blake2b256", HashLen: 32, Prefix: []byte{0x00}}
+
+	//CSPR stuff, the hex of the algorithm byte and the public key, the account hash is the blake2b-256 of the algorithm name, a 0 byte and the public key
+	//an ed25519 public key is 32 bytes like the account hash, AddressEncode takes it as the hash itself, use GenerateAddress for the account hash of a key
+	CSPR_publicKeyEd25519     = AddressType{EncodeType: "hex", HashLen: 32, Prefix: []byte{0x01}}
+	CSPR_publicKeySecp256k1   = AddressType{EncodeType: "hex", HashLen: 33, Prefix: []byte{0x02}}
+	CSPR_accountHashEd25519   = AddressType{EncodeType: "hex", ChecksumType: "account-hash-", HashType: "casper_ed25519", HashLen: 32}
+	CSPR_accountHashSecp256k1 = AddressType{EncodeType: "hex", ChecksumType: "account-hash-", HashType: "casper_secp256k1", HashLen: 32}
+
+	//KAS stuff, the cashaddr checksum over the version byte and the schnorr or ecdsa public key, or the blake2b-256 of a script
+	KAS_mainnetAddressSchnorr = AddressType{EncodeType: "kaspa", Alphabet: KASAlphabet, ChecksumType: "kaspa", HashLen: 32, Prefix: []byte{0x00}}
+	KAS_mainnetAddressECDSA   = AddressType{EncodeType: "kaspa", Alphabet: KASAlphabet, ChecksumType: "kaspa", HashLen: 33, Prefix: []byte{0x01}}
+	KAS_mainnetAddressP2SH    = AddressType{EncodeType: "kaspa", Alphabet: KASAlphabet, ChecksumType: "kaspa", HashType: "blake2b256", HashLen: 32, Prefix: []byte{0x08}}
+	KAS_testnetAddressSchnorr = AddressType{EncodeType: "kaspa", Alphabet: KASAlphabet, ChecksumType: "kaspatest", HashLen: 32, Prefix: []byte{0x00}}
+	KAS_testnetAddressECDSA   = AddressType{EncodeType: "kaspa", Alphabet: KASAlphabet, ChecksumType: "kaspatest", HashLen: 33, Prefix: []byte{0x01}}
+	KAS_testnetAddressP2SH    = AddressType{EncodeType: "kaspa", Alphabet: KASAlphabet, ChecksumType: "kaspatest", HashType: "blake2b256", HashLen: 32, Prefix: []byte{0x08}}
 )
@@ -0,0 +1,30 @@
+package addressEncoder
+
+// Presets of bitcoin forks, all of them on the base58check path with single byte version prefixes
+var (
+	//FIRO(formerly Zcoin) stuff
+	FIRO_mainnetAddressP2PKH = AddressType{EncodeType: "base58", Alphabet: BTCAlphabet, ChecksumType: "doubleSHA256", HashType: "h160", HashLen: 20, Prefix: []byte{0x52}}
+	FIRO_mainnetAddressP2SH  = AddressType{EncodeType: "base58", Alphabet: BTCAlphabet, ChecksumType: "doubleSHA256", HashType: "h160", HashLen: 20, ScriptHash: true, Prefix: []byte{0x07}}
+	FIRO_mainnetPrivateWIF   = AddressType{EncodeType: "base58", Alphabet: BTCAlphabet, ChecksumType: "doubleSHA256", HashLen: 32, Prefix: []byte{0xD2}}
+	FIRO_testnetAddressP2PKH = AddressType{EncodeType: "base58", Alphabet: BTCAlphabet, ChecksumType: "doubleSHA256", HashType: "h160", HashLen: 20, Prefix: []byte{0x41}}
+	FIRO_testnetAddressP2SH  = AddressType{EncodeType: "base58", Alphabet: BTCAlphabet, ChecksumType: "doubleSHA256", HashType: "h160", HashLen: 20, ScriptHash: true, Prefix: []byte{0xB2}}
+
+	//PIVX stuff
+	PIVX_mainnetAddressP2PKH = AddressType{EncodeType: "base58", Alphabet: BTCAlphabet, ChecksumType: "doubleSHA256", HashType: "h160", HashLen: 20, Prefix: []byte{0x1E}}
+	PIVX_mainnetAddressP2SH  = AddressType{EncodeType: "base58", Alphabet: BTCAlphabet, ChecksumType: "doubleSHA256", HashType: "h160", HashLen: 20, ScriptHash: true, Prefix: []byte{0x0D}}
+	PIVX_mainnetPrivateWIF   = AddressType{EncodeType: "base58", Alphabet: BTCAlphabet, ChecksumType: "doubleSHA256", HashLen: 32, Prefix: []byte{0xD4}}
+	PIVX_testnetAddressP2PKH = AddressType{EncodeType: "base58", Alphabet: BTCAlphabet, ChecksumType: "doubleSHA256", HashType: "h160", HashLen: 20, Prefix: []byte{0x8B}}
+	PIVX_testnetAddressP2SH  = AddressType{EncodeType: "base58", Alphabet: BTCAlphabet, ChecksumType: "doubleSHA256", HashType: "h160", HashLen: 20, ScriptHash: true, Prefix: []byte{0x13}}
+
+	//RVN stuff
+	RVN_mainnetAddressP2PKH = AddressType{EncodeType: "base58", Alphabet: BTCAlphabet, ChecksumType: "doubleSHA256", HashType: "h160", HashLen: 20, Prefix: []byte{0x3C}}
+	RVN_mainnetAddressP2SH  = AddressType{EncodeType: "base58", Alphabet: BTCAlphabet, ChecksumType: "doubleSHA256", HashType: "h160", HashLen: 20, ScriptHash: true, Prefix: []byte{0x7A}}
+	RVN_mainnetPrivateWIF   = AddressType{EncodeType: "base58", Alphabet: BTCAlphabet, ChecksumType: "doubleSHA256", HashLen: 32, Prefix: []byte{0x80}}
+	RVN_testnetAddressP2PKH = AddressType{EncodeType: "base58", Alphabet: BTCAlphabet, ChecksumType: "doubleSHA256", HashType: "h160", HashLen: 20, Prefix: []byte{0x6F}}
+	RVN_testnetAddressP2SH  = AddressType{EncodeType: "base58", Alphabet: BTCAlphabet, ChecksumType: "doubleSHA256", HashType: "h160", HashLen: 20, ScriptHash: true, Prefix: []byte{0xC4}}
+
+	//NMC stuff
+	NMC_mainnetAddressP2PKH = AddressType{EncodeType: "base58", Alphabet: BTCAlphabet, ChecksumType: "doubleSHA256", HashType: "h160", HashLen: 20, Prefix: []byte{0x34}}
+	NMC_mainnetAddressP2SH  = AddressType{EncodeType: "base58", Alphabet: BTCAlphabet, ChecksumType: "doubleSHA256", HashType: "h160", HashLen: 20, ScriptHash: true, Prefix: []byte{0x0D}}
+	NMC_mainnetPrivateWIF   = AddressType{EncodeType: "base58", Alphabet: BTCAlphabet, ChecksumType: "doubleSHA256", HashLen: 32, Prefix: []byte{0xB4}}
+)
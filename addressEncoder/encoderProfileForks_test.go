@@ -0,0 +1,95 @@
+package addressEncoder
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func Test_forkPresets(t *testing.T) {
+	// the founders' addresses of the firo (zcoin) chain parameters, a pivx mainnet address, the ravencoin
+	// burn addresses and namecoin mainnet addresses
+	published := []struct {
+		addresstype AddressType
+		address     string
+		hash        string
+	}{
+		{FIRO_mainnetAddressP2PKH, "aCAgTPgtYcA4EysU4UKC86EQd5cTtHtCcr", "7d9ed014fc4e603fca7c2e3f9097fb7d0fb487fc"},
+		{FIRO_mainnetAddressP2PKH, "aHu897ivzmeFuLNB6956X6gyGeVNHUBRgD", "bc7e5a5234db3ab82d74c396ad2b2af419b75174"},
+		{FIRO_testnetAddressP2PKH, "TDk19wPKYq91i18qmY6U9FeTdTxwPeSveo", "296134d2415bf1f2b518b3f673816d7e603b1600"},
+		{PIVX_mainnetAddressP2PKH, "DMJRSsuU9zfyrvxVaAEFQqK4MxZg6vgeS6", "b1458a06e0ae0084705a91bc6b383068293a6aca"},
+		{RVN_mainnetAddressP2PKH, "RXissueSubAssetXXXXXXXXXXXXXWcwhwL", "f62e63b9476bac1b9328326d668fa018c7220ade"},
+		{RVN_testnetAddressP2PKH, "n1issueAssetXXXXXXXXXXXXXXXXWdnemQ", "dda3d21797ff26cb8ae9a769bdc68cf4567f5bba"},
+		{RVN_testnetAddressP2PKH, "n1ReissueAssetXXXXXXXXXXXXXXWG9NLd", "da61c47adbad4a81e5f14e1fabb3d167a51ca448"},
+		{RVN_testnetAddressP2PKH, "n1BurnXXXXXXXXXXXXXXXXXXXXXXU1qejP", "d7c8944771bbfe427418f27320e72a1322faf134"},
+		{NMC_mainnetAddressP2PKH, "N2pGWAh65TWpWmEFrFssRQkQubbczJSKi9", "4471d26051feedf7864fb9b0993b62631f33f2a6"},
+		{NMC_mainnetAddressP2PKH, "N1KHAL5C1CRzy58NdJwp1tbLze3XrkFxx9", "33fe2eae26570bc96166d494612c99cf07cc8ef1"},
+	}
+	for _, v := range published {
+		ret, err := AddressDecode(v.address, v.addresstype)
+		if err != nil || hex.EncodeToString(ret) != v.hash {
+			t.Errorf("decode %s failed: %x, %v", v.address, ret, err)
+			continue
+		}
+		if address := AddressEncode(ret, v.addresstype); address != v.address {
+			t.Errorf("encode %s: got %s, want %s", v.hash, address, v.address)
+		}
+	}
+
+	// no published address was at hand for these, the vectors encode one hash under each version byte
+	hash, _ := hex.DecodeString("6231f1005e86c03d5fbd41776985d094ccb682d3")
+	computed := []struct {
+		addresstype AddressType
+		address     string
+	}{
+		{FIRO_mainnetAddressP2SH, "3yKRwYswCz9FCsbjtnm9dvdaeV9C26YKv8"},
+		{FIRO_testnetAddressP2SH, "2En7aK7rCcqP53zSZ5XieZNB7GkCVdrjfuh"},
+		{PIVX_mainnetAddressP2SH, "6PM3rCffU4vW7USG3Jm4YgGJRWgrLkkMBA"},
+		{PIVX_testnetAddressP2PKH, "y5w3utDxvoJuA61B8AjDeUZRj59hvbJc54"},
+		{PIVX_testnetAddressP2SH, "8oNfkrTPj9hm25GnBpkyTRu2CYEWcKKwzC"},
+		{RVN_mainnetAddressP2SH, "rFBoB3A4rjS1Fidhi34oQLw42VmfqBbSB1"},
+		{RVN_testnetAddressP2SH, "2N2CS35DPQ5iqmnx7X5iPHd5GbpqUNNrpUR"},
+		{NMC_mainnetAddressP2SH, "6PM3rCffU4vW7USG3Jm4YgGJRWgrLkkMBA"},
+	}
+	for _, v := range computed {
+		if address := AddressEncode(hash, v.addresstype); address != v.address {
+			t.Errorf("encode with prefix %x: got %s, want %s", v.addresstype.Prefix, address, v.address)
+		}
+		ret, err := AddressDecode(v.address, v.addresstype)
+		if err != nil || hex.EncodeToString(ret) != hex.EncodeToString(hash) {
+			t.Errorf("decode %s failed: %x, %v", v.address, ret, err)
+		}
+	}
+
+	// a prefix must not be accepted for another
+	if _, err := AddressDecode("aCAgTPgtYcA4EysU4UKC86EQd5cTtHtCcr", PIVX_mainnetAddressP2PKH); err == nil {
+		t.Error("firo address decoded as pivx")
+	}
+}
+
+func Test_RVN_NMC_address(t *testing.T) {
+	// the published ravencoin burn addresses
+	for address, burn := range map[string]string{
+		"RXissueAssetXXXXXXXXXXXXXXXXXhhZGt": "f62e63b933953a680f3c3a63324948293ba47d16",
+		"RXReissueAssetXXXXXXXXXXXXXXVEFAWu": "f2ec561c77435e1e6a43e11920368c9c8a41c5a4",
+		"RXBurnXXXXXXXXXXXXXXXXXXXXXXWUo9FV": "f05325e90d5211def86b856c9569e54808201290",
+	} {
+		ret, err := AddressDecode(address, RVN_mainnetAddressP2PKH)
+		if err != nil || hex.EncodeToString(ret) != burn {
+			t.Errorf("decode %s failed: %x, %v", address, ret, err)
+			continue
+		}
+		if encoded := AddressEncode(ret, RVN_mainnetAddressP2PKH); encoded != address {
+			t.Errorf("encode %s: got %s", burn, encoded)
+		}
+		// a ravencoin address fails under the namecoin preset
+		if _, err := AddressDecode(address, NMC_mainnetAddressP2PKH); err != ErrorInvalidAddress {
+			t.Errorf("%s decoded as namecoin: %v", address, err)
+		}
+	}
+	if _, err := AddressDecode("N2pGWAh65TWpWmEFrFssRQkQubbczJSKi9", RVN_mainnetAddressP2PKH); err != ErrorInvalidAddress {
+		t.Error("namecoin address decoded as ravencoin:", err)
+	}
+	if _, err := PubKeyHash("rFBoB3A4rjS1Fidhi34oQLw42VmfqBbSB1", RVN_mainnetAddressP2SH); err != ErrorNotPubKeyHash {
+		t.Error("ravencoin p2sh taken as a public key hash:", err)
+	}
+}
@@ -0,0 +1,58 @@
+package addressEncoder
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+func Test_AddressType_Clone(t *testing.T) {
+	base := AddressType{EncodeType: "base58", Alphabet: BTCAlphabet, ChecksumType: "doubleSHA256", HashType: "h160", HashLen: 20,
+		Prefix: []byte{0x00}, Suffix: []byte{0x01, 0x02}}
+
+	clone := base.Clone()
+	clone.Prefix[0] = 0x05
+	clone.Suffix[1] = 0xff
+	if base.Prefix[0] != 0x00 || base.Suffix[1] != 0x02 {
+		t.Errorf("clone shares memory with the original: %x %x", base.Prefix, base.Suffix)
+	}
+	if clone := BTC_mainnetAddressP2PKH.Clone(); clone.Suffix != nil {
+		t.Error("nil suffix cloned as", clone.Suffix)
+	}
+	lens := BTC_mainnetAddressBech32V0.Clone()
+	lens.HashLens[1] = 40
+	if BTC_mainnetAddressBech32V0.HashLens[1] != 32 {
+		t.Error("clone shares HashLens with the preset:", BTC_mainnetAddressBech32V0.HashLens)
+	}
+	if testnet := BTC_mainnetAddressBech32V0.WithHRP("tb"); &testnet.HashLens[0] == &BTC_mainnetAddressBech32V0.HashLens[0] {
+		t.Error("WithHRP shares HashLens with the preset")
+	}
+	if clone := BTC_mainnetAddressP2PKH.Clone(); clone.HashLens != nil {
+		t.Error("nil HashLens cloned as", clone.HashLens)
+	}
+
+	prefix := []byte{0x1e}
+	doge := base.WithPrefix(prefix)
+	prefix[0] = 0x00
+	if !bytes.Equal(doge.Prefix, []byte{0x1e}) || !bytes.Equal(base.Prefix, []byte{0x00}) {
+		t.Errorf("WithPrefix: got %x, original %x", doge.Prefix, base.Prefix)
+	}
+	doge.Suffix[0] = 0xff
+	if base.Suffix[0] != 0x01 {
+		t.Error("WithPrefix shares the suffix with the original")
+	}
+
+	hash, _ := hex.DecodeString("751e76e8199196d454941c45d1b3a323f1433bd6")
+	testnet := BTC_mainnetAddressBech32V0.WithHRP("tb")
+	if ret := AddressEncode(hash, testnet); ret != "tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx" {
+		t.Error("WithHRP wrong result:", ret)
+	}
+	if BTC_mainnetAddressBech32V0.ChecksumType != "bc" {
+		t.Error("WithHRP changed the original to", BTC_mainnetAddressBech32V0.ChecksumType)
+	}
+
+	keccak := base.WithChecksumType("keccak256")
+	if keccak.ChecksumType != "keccak256" || base.ChecksumType != "doubleSHA256" {
+		t.Errorf("WithChecksumType: got %s, original %s", keccak.ChecksumType, base.ChecksumType)
+	}
+}
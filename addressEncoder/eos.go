@@ -33,7 +33,11 @@ func decodeEOS(pubKey string, addresstype AddressType) ([]byte, error) {
 		return nil, ErrorInvalidAddress
 	}
 
-	return ret[:len(ret)-4], nil
+	ret = ret[:len(ret)-checksumLength(addresstype.ChecksumType)]
+	if err := checkLength(len(ret), addresstype); err != nil {
+		return nil, err
+	}
+	return ret, nil
 }
 
 func encodeEOS(hash []byte, addresstype AddressType) string {
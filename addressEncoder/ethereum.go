@@ -0,0 +1,134 @@
+package addressEncoder
+
+import (
+	"encoding/hex"
+	"errors"
+	"strings"
+	"unicode"
+
+	"github.com/blocktree/go-owcrypt"
+)
+
+var (
+	ErrorENSName = errors.New("Not an address, but an ENS name!")
+)
+
+// eip55Checksum returns the 0x prefixed, mixed case checksummed form of a 20-byte address
+func eip55Checksum(addr []byte) string {
+	lower := []byte(hex.EncodeToString(addr))
+	hash := owcrypt.Hash(lower, 0, owcrypt.HASH_ALG_KECCAK256)
+	for i, c := range lower {
+		nibble := hash[i/2] >> 4
+		if i%2 == 1 {
+			nibble = hash[i/2] & 0x0f
+		}
+		if c >= 'a' && nibble >= 8 {
+			lower[i] = c - 32
+		}
+	}
+	return "0x" + string(lower)
+}
+
+// rlpUint encodes an unsigned integer as an rlp string
+func rlpUint(v uint64) []byte {
+	if v == 0 {
+		return []byte{0x80}
+	}
+	if v < 0x80 {
+		return []byte{byte(v)}
+	}
+	var be []byte
+	for ; v > 0; v >>= 8 {
+		be = append([]byte{byte(v)}, be...)
+	}
+	return append([]byte{0x80 + byte(len(be))}, be...)
+}
+
+// ContractAddressCreate computes the address of a contract deployed by sender with CREATE,
+// keccak256(rlp([sender, nonce]))[12:], in eip55 checksum form.
+func ContractAddressCreate(sender []byte, nonce uint64) (string, error) {
+	if len(sender) != 20 {
+		return "", ErrorInvalidAddress
+	}
+	// both items are short, so the list payload never exceeds 55 bytes
+	payload := append([]byte{0x80 + 20}, sender...)
+	payload = append(payload, rlpUint(nonce)...)
+	data := append([]byte{0xc0 + byte(len(payload))}, payload...)
+	return eip55Checksum(owcrypt.Hash(data, 0, owcrypt.HASH_ALG_KECCAK256)[12:]), nil
+}
+
+// ContractAddressCreate2 computes the address of a contract deployed by sender with CREATE2 as defined in EIP-1014,
+// keccak256(0xff ++ sender ++ salt ++ initCodeHash)[12:], in eip55 checksum form.
+func ContractAddressCreate2(sender, salt, initCodeHash []byte) (string, error) {
+	if len(sender) != 20 {
+		return "", ErrorInvalidAddress
+	}
+	if len(salt) != 32 || len(initCodeHash) != 32 {
+		return "", ErrorInvalidHashLength
+	}
+	data := make([]byte, 0, 85)
+	data = append(data, 0xff)
+	data = append(data, sender...)
+	data = append(data, salt...)
+	data = append(data, initCodeHash...)
+	return eip55Checksum(owcrypt.Hash(data, 0, owcrypt.HASH_ALG_KECCAK256)[12:]), nil
+}
+
+// EthereumAddressFromPubkey derives the eip55 address of an uncompressed secp256k1 public key,
+// keccak256(X||Y)[12:]. The key is either the 64-byte X||Y or the 65-byte form with its 0x04 prefix.
+func EthereumAddressFromPubkey(pubkey []byte) (string, error) {
+	if len(pubkey) == 65 && pubkey[0] == 0x04 {
+		pubkey = pubkey[1:]
+	}
+	if len(pubkey) != 64 {
+		return "", ErrorInvalidPubKey
+	}
+	return eip55Checksum(owcrypt.Hash(pubkey, 0, owcrypt.HASH_ALG_KECCAK256)[12:]), nil
+}
+
+// FromEthereumAddress re-encodes the 20 bytes of the 0x prefixed ethereum address ethAddr as an address of
+// addresstype, for chains sharing the ethereum addresses under another encoding such as tron or harmony.
+// ethAddr must be in a single case or carry a valid eip55 checksum.
+func FromEthereumAddress(ethAddr string, addresstype AddressType) (string, error) {
+	if !IsEthAddressOrName(ethAddr) {
+		return "", ErrorInvalidAddress
+	}
+	addr, _ := hex.DecodeString(ethAddr[2:])
+	return EncodeHash(addr, addresstype)
+}
+
+// IsENSName reports whether s is a syntactically valid ENS name such as "vitalik.eth":
+// two or more non-empty labels of letters, digits, hyphens and underscores. The name is not resolved.
+func IsENSName(s string) bool {
+	labels := strings.Split(s, ".")
+	if len(labels) < 2 {
+		return false
+	}
+	for _, label := range labels {
+		if label == "" {
+			return false
+		}
+		for _, r := range label {
+			if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '-' && r != '_' {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// IsEthAddressOrName reports whether s is a 0x prefixed address, either in a single case or
+// with a valid eip55 checksum. It is false for ENS names, use IsENSName to tell them from garbage.
+func IsEthAddressOrName(s string) (isAddress bool) {
+	if len(s) != 42 || s[:2] != "0x" {
+		return false
+	}
+	addr, err := hex.DecodeString(s[2:])
+	if err != nil {
+		return false
+	}
+	if strings.ToLower(s[2:]) == s[2:] || strings.ToUpper(s[2:]) == s[2:] {
+		return true
+	}
+	return eip55Checksum(addr) == s
+}
@@ -0,0 +1,180 @@
+package addressEncoder
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/blocktree/go-owcrypt"
+)
+
+func Test_eip55Checksum(t *testing.T) {
+	for _, address := range []string{
+		"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		"0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359",
+		"0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB",
+		"0xD1220A0cf47c7B9Be7A2E6BA89F429762e7b9aDb",
+	} {
+		addr, _ := hex.DecodeString(strings.ToLower(address[2:]))
+		if ret := eip55Checksum(addr); ret != address {
+			t.Errorf("eip55 checksum %s, want %s", ret, address)
+		}
+	}
+}
+
+func Test_rlpUint(t *testing.T) {
+	expect := map[uint64]string{0: "80", 1: "01", 0x7f: "7f", 0x80: "8180", 0x400: "820400", 0xffffffffffffffff: "88ffffffffffffffff"}
+	for v, enc := range expect {
+		if ret := hex.EncodeToString(rlpUint(v)); ret != enc {
+			t.Errorf("rlp of %d is %s, want %s", v, ret, enc)
+		}
+	}
+}
+
+func Test_ContractAddressCreate(t *testing.T) {
+	sender, _ := hex.DecodeString("6ac7ea33f8831ea9dcc53393aaa88b25a785dbf0")
+	expect := []string{
+		"0xcd234a471b72ba2f1ccf0a70fcaba648a5eecd8d",
+		"0x343c43a37d37dff08ae8c4a11544c718abb4fcf8",
+		"0xf778b86fa74e846c4f0a1fbd1335fe81c00a0c91",
+		"0xfffd933a0bc612844eaf0c6fe3e5b8e9b6c1d19c",
+	}
+	for nonce, address := range expect {
+		ret, err := ContractAddressCreate(sender, uint64(nonce))
+		if err != nil || strings.ToLower(ret) != address {
+			t.Errorf("nonce %d: contract address %s, want %s", nonce, ret, address)
+		}
+		addr, _ := hex.DecodeString(address[2:])
+		if ret != eip55Checksum(addr) {
+			t.Errorf("nonce %d: contract address not checksummed: %s", nonce, ret)
+		}
+	}
+	if _, err := ContractAddressCreate(sender[:19], 0); err == nil {
+		t.Error("short sender should be rejected")
+	}
+}
+
+func Test_ContractAddressCreate2(t *testing.T) {
+	// EIP-1014 examples
+	vectors := []struct {
+		sender, salt, initCode, address string
+	}{
+		{"0000000000000000000000000000000000000000", "0000000000000000000000000000000000000000000000000000000000000000", "00", "0x4D1A2e2bB4F88F0250f26Ffff098B0b30B26BF38"},
+		{"deadbeef00000000000000000000000000000000", "0000000000000000000000000000000000000000000000000000000000000000", "00", "0xB928f69Bb1D91Cd65274e3c79d8986362984fDA3"},
+		{"deadbeef00000000000000000000000000000000", "000000000000000000000000feed000000000000000000000000000000000000", "00", "0xD04116cDd17beBE565EB2422F2497E06cC1C9833"},
+		{"0000000000000000000000000000000000000000", "0000000000000000000000000000000000000000000000000000000000000000", "deadbeef", "0x70f2b2914A2a4b783FaEFb75f459A580616Fcb5e"},
+		{"00000000000000000000000000000000deadbeef", "00000000000000000000000000000000000000000000000000000000cafebabe", "deadbeef", "0x60f3f640a8508fC6a86d45DF051962668E1e8AC7"},
+		{"00000000000000000000000000000000deadbeef", "00000000000000000000000000000000000000000000000000000000cafebabe", strings.Repeat("deadbeef", 11), "0x1d8bfDC5D46DC4f61D6b6115972536eBE6A8854C"},
+		{"0000000000000000000000000000000000000000", "0000000000000000000000000000000000000000000000000000000000000000", "", "0xE33C0C7F7df4809055C3ebA6c09CFe4BaF1BD9e0"},
+	}
+	for _, v := range vectors {
+		sender, _ := hex.DecodeString(v.sender)
+		salt, _ := hex.DecodeString(v.salt)
+		initCode, _ := hex.DecodeString(v.initCode)
+		ret, err := ContractAddressCreate2(sender, salt, owcrypt.Hash(initCode, 0, owcrypt.HASH_ALG_KECCAK256))
+		if err != nil || ret != v.address {
+			t.Errorf("create2 address %s, want %s", ret, v.address)
+		}
+	}
+
+	zero := make([]byte, 32)
+	if _, err := ContractAddressCreate2(zero[:19], zero, zero); err == nil {
+		t.Error("short sender should be rejected")
+	}
+	if _, err := ContractAddressCreate2(zero[:20], zero[:31], zero); err == nil {
+		t.Error("short salt should be rejected")
+	}
+	if _, err := ContractAddressCreate2(zero[:20], zero, zero[:31]); err == nil {
+		t.Error("short init code hash should be rejected")
+	}
+}
+
+func Test_EthereumAddressFromPubkey(t *testing.T) {
+	// public key of the private key 1, the generator point
+	pubkey, _ := hex.DecodeString("0479be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b8")
+	expect := "0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf"
+	for _, key := range [][]byte{pubkey, pubkey[1:]} {
+		if ret, err := EthereumAddressFromPubkey(key); err != nil || ret != expect {
+			t.Errorf("%d byte key: address %s, want %s (%v)", len(key), ret, expect, err)
+		}
+	}
+	compressed := append([]byte{0x02}, pubkey[1:33]...)
+	for _, key := range [][]byte{compressed, pubkey[1:64], append([]byte{0x03}, pubkey[1:]...)} {
+		if _, err := EthereumAddressFromPubkey(key); err != ErrorInvalidPubKey {
+			t.Errorf("%x should be rejected, got %v", key, err)
+		}
+	}
+}
+
+func Test_IsEthAddressOrName(t *testing.T) {
+	vectors := []struct {
+		s                 string
+		isAddress, isName bool
+	}{
+		{"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", true, false},
+		{"0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", true, false},
+		{"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD", false, false},
+		{"vitalik.eth", false, true},
+		{"pay.alice-01.eth", false, true},
+		{"vitalik", false, false},
+		{"vitalik..eth", false, false},
+		{"vitalik.eth/", false, false},
+		{"0x5aaeb6053f3e94c9b9a09f33669435e7ef1bea", false, false},
+		{"", false, false},
+	}
+	for _, v := range vectors {
+		if IsEthAddressOrName(v.s) != v.isAddress {
+			t.Errorf("%q: isAddress should be %v", v.s, v.isAddress)
+		}
+		if IsENSName(v.s) != v.isName {
+			t.Errorf("%q: isName should be %v", v.s, v.isName)
+		}
+	}
+
+	if _, err := AddressDecode("vitalik.eth", ETH_mainnetPublicAddress); err != ErrorENSName {
+		t.Errorf("ens name should be rejected as a name: %v", err)
+	}
+	if _, err := AddressDecode("0xzz", ETH_mainnetPublicAddress); err != ErrorInvalidAddress {
+		t.Errorf("garbage should be rejected as invalid: %v", err)
+	}
+}
+
+func Test_FromEthereumAddress(t *testing.T) {
+	vectors := []struct {
+		eth, address string
+		addresstype  AddressType
+	}{
+		{"0xebcd16e8c1d8f493ba04e99a56474122d81a9c58", "one1a0x3d6xpmr6f8wsyaxd9v36pytvp48zckswvv9", ONE_mainnetAddress},
+		{"0xebcd16e8c1d8f493ba04e99a56474122d81a9c58", "TXU1V1LgWJFH4c1jN4Xm5bpz3HsefmYbAi", TRON_mainnetAddress},
+		// the tron USDT contract
+		{"0xa614f803b6fd780986a42c78ec9c7f77e6ded13c", "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t", TRON_mainnetAddress},
+		{"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", "5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", ETH_mainnetPublicAddress},
+	}
+	for _, v := range vectors {
+		if ret, err := FromEthereumAddress(v.eth, v.addresstype); err != nil || ret != v.address {
+			t.Errorf("%s: got %s, %v, want %s", v.eth, ret, err, v.address)
+		}
+	}
+
+	for _, eth := range []string{
+		"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD", // broken checksum
+		"ebcd16e8c1d8f493ba04e99a56474122d81a9c58",
+		"0xebcd16e8c1d8f493ba04e99a56474122d81a9c",
+	} {
+		if _, err := FromEthereumAddress(eth, TRON_mainnetAddress); err != ErrorInvalidAddress {
+			t.Errorf("%s converted: %v", eth, err)
+		}
+	}
+}
+
+func Test_eip55_short_input(t *testing.T) {
+	// input too short to hold a 0x is rejected rather than sliced
+	for _, address := range []string{"", "a", "0", "0x", "0x1"} {
+		if _, err := AddressDecode(address, ETH_mainnetPublicAddress); err == nil {
+			t.Errorf("%q decoded", address)
+		}
+		if _, err := DetectAddressType(address, []AddressType{ETH_mainnetPublicAddress}); err == nil {
+			t.Errorf("%q detected", address)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package addressEncoder
+
+import (
+	"encoding/base32"
+	"strings"
+)
+
+// filProtocol returns the protocol byte given by the digit that ends the prefix of addresstype,
+// it is what the checksum covers instead of the printed prefix
+func filProtocol(addresstype AddressType) byte {
+	if len(addresstype.Prefix) == 0 {
+		return 0
+	}
+	return addresstype.Prefix[len(addresstype.Prefix)-1] - '0'
+}
+
+func filEncoding(addresstype AddressType) *base32.Encoding {
+	return base32.NewEncoding(addresstype.Alphabet).WithPadding(base32.NoPadding)
+}
+
+func encodeFIL(hash []byte, addresstype AddressType) string {
+	if len(hash) != addresstype.HashLen {
+		return ""
+	}
+	checksum := calcChecksum(catData([]byte{filProtocol(addresstype)}, hash), addresstype.ChecksumType)
+	return string(addresstype.Prefix) + filEncoding(addresstype).EncodeToString(catData(hash, checksum))
+}
+
+func decodeFIL(address string, addresstype AddressType) ([]byte, error) {
+	if !strings.HasPrefix(address, string(addresstype.Prefix)) {
+		return nil, ErrorInvalidAddress
+	}
+	encoded := address[len(addresstype.Prefix):]
+	data, err := filEncoding(addresstype).DecodeString(encoded)
+	if err != nil || len(data) <= checksumLength(addresstype.ChecksumType) {
+		return nil, ErrorInvalidAddress
+	}
+	//the unused bits of the last character must be zero
+	if filEncoding(addresstype).EncodeToString(data) != encoded {
+		return nil, ErrorInvalidAddress
+	}
+	if !verifyChecksum(catData([]byte{filProtocol(addresstype)}, data), addresstype.ChecksumType) {
+		return nil, ErrorInvalidAddress
+	}
+	hash := data[:len(data)-checksumLength(addresstype.ChecksumType)]
+	if err := checkLength(len(hash), addresstype); err != nil {
+		return nil, err
+	}
+	return hash, nil
+}
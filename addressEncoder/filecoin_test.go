@@ -0,0 +1,64 @@
+package addressEncoder
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func Test_FIL_BLS_address(t *testing.T) {
+	// the BLS12-381 G1 generator, the public key of the secret key 1
+	pubkey, _ := hex.DecodeString("97f1d3a73197d7942695638c4fa9ac0fc3688c4f9774b905a14e3a3f171bac586c55e83ff97a1aeffb3af00adb22c6bb")
+	address := "f3s7y5hjzrs7lzijuvmoge7knmb7bwrdcps52lsbnbjy5d6fy3vrmgyvpih74xugxp7m5pacw3eldlw5rocaha"
+
+	if ret := AddressEncode(pubkey, FIL_mainnetAddressBLS); ret != address {
+		t.Error("encode wrong result:", ret)
+	}
+	ret, err := AddressDecode(address, FIL_mainnetAddressBLS)
+	if err != nil || hex.EncodeToString(ret) != hex.EncodeToString(pubkey) {
+		t.Error("decode wrong result:", hex.EncodeToString(ret), err)
+	}
+
+	testnet := AddressEncode(pubkey, FIL_testnetAddressBLS)
+	if testnet != "t"+address[1:] {
+		t.Error("testnet encode wrong result:", testnet)
+	}
+	if _, err := AddressDecode(testnet, FIL_mainnetAddressBLS); err == nil {
+		t.Error("testnet address decoded as mainnet")
+	}
+
+	// the key must be 48 bytes
+	if ret := AddressEncode(pubkey[:33], FIL_mainnetAddressBLS); ret != "" {
+		t.Error("33 bytes key encoded:", ret)
+	}
+	short := "f3" + filEncoding(FIL_mainnetAddressBLS).EncodeToString(catData(pubkey[:33], calcChecksum(catData([]byte{3}, pubkey[:33]), "blake2b32")))
+	if _, err := AddressDecode(short, FIL_mainnetAddressBLS); err != ErrorInvalidHashLength {
+		t.Error("33 bytes key decoded:", err)
+	}
+
+	// a changed character breaks the checksum
+	broken := address[:10] + "a" + address[11:]
+	if _, err := AddressDecode(broken, FIL_mainnetAddressBLS); err != ErrorInvalidAddress {
+		t.Error("corrupted address decoded:", err)
+	}
+}
+
+func Test_FIL_secp256k1_address(t *testing.T) {
+	hash, _ := hex.DecodeString("fd1d0f4dfcd7e99afcb99a8326b7dc459d32c628")
+	address := "f17uoq6tp427uzv7fztkbsnn64iwotfrristwpryy"
+
+	if ret := AddressEncode(hash, FIL_mainnetAddressSecp256k1); ret != address {
+		t.Error("encode wrong result:", ret)
+	}
+	ret, err := AddressDecode(address, FIL_mainnetAddressSecp256k1)
+	if err != nil || hex.EncodeToString(ret) != hex.EncodeToString(hash) {
+		t.Error("decode wrong result:", hex.EncodeToString(ret), err)
+	}
+	if ret := AddressEncode(hash, FIL_testnetAddressSecp256k1); ret != "t"+address[1:] {
+		t.Error("testnet encode wrong result:", ret)
+	}
+
+	// the protocol digit is covered by the checksum
+	if _, err := AddressDecode("f3"+address[2:], FIL_mainnetAddressBLS); err == nil {
+		t.Error("secp256k1 address decoded as BLS")
+	}
+}
@@ -0,0 +1,19 @@
+package addressEncoder
+
+// EthToOne converts a 0x prefixed ethereum style address, in a single case or with a valid eip55
+// checksum, to the one1 bech32 form harmony gives the same key.
+func EthToOne(address string) (string, error) {
+	return FromEthereumAddress(address, ONE_mainnetAddress)
+}
+
+// OneToEth converts a one1 bech32 address to the eip55 checksummed 0x form.
+func OneToEth(address string) (string, error) {
+	hash, err := AddressDecode(address, ONE_mainnetAddress)
+	if err != nil {
+		return "", err
+	}
+	if len(hash) != ONE_mainnetAddress.HashLen {
+		return "", ErrorInvalidHashLength
+	}
+	return eip55Checksum(hash), nil
+}
@@ -0,0 +1,49 @@
+package addressEncoder
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func Test_ONE_address(t *testing.T) {
+	hash, _ := hex.DecodeString("ebcd16e8c1d8f493ba04e99a56474122d81a9c58")
+	address := "one1a0x3d6xpmr6f8wsyaxd9v36pytvp48zckswvv9"
+
+	if ret := AddressEncode(hash, ONE_mainnetAddress); ret != address {
+		t.Error("encode wrong result:", ret)
+	}
+	ret, err := AddressDecode(address, ONE_mainnetAddress)
+	if err != nil || hex.EncodeToString(ret) != hex.EncodeToString(hash) {
+		t.Error("decode wrong result:", hex.EncodeToString(ret), err)
+	}
+
+	// the hash of a key is the one of its ethereum address
+	pubkey, _ := hex.DecodeString("79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798" +
+		"483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b8")
+	eth, _ := EthereumAddressFromPubkey(pubkey)
+	one, err := GenerateAddress(pubkey, ONE_mainnetAddress)
+	if back, _ := OneToEth(one); err != nil || back != eth {
+		t.Errorf("address of the key: %s is %s, want %s", one, back, eth)
+	}
+}
+
+func Test_EthOne(t *testing.T) {
+	checksummed := "0xeBCD16e8c1D8f493bA04E99a56474122D81A9c58"
+	one := "one1a0x3d6xpmr6f8wsyaxd9v36pytvp48zckswvv9"
+
+	for _, in := range []string{checksummed, "0xebcd16e8c1d8f493ba04e99a56474122d81a9c58"} {
+		if ret, err := EthToOne(in); err != nil || ret != one {
+			t.Errorf("%s: got %s, %v", in, ret, err)
+		}
+	}
+	if ret, err := OneToEth(one); err != nil || ret != checksummed {
+		t.Errorf("got %s, %v, want %s", ret, err, checksummed)
+	}
+
+	if _, err := EthToOne("0xeBCD16e8c1D8f493bA04E99a56474122D81A9C58"); err != ErrorInvalidAddress {
+		t.Error("broken checksum accepted:", err)
+	}
+	if _, err := OneToEth("zil1n0lvw9dxh4jcljmzkruvexl69t08zs62ds9ats"); err == nil {
+		t.Error("zilliqa address converted")
+	}
+}
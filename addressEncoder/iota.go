@@ -0,0 +1,49 @@
+package addressEncoder
+
+import (
+	"bytes"
+
+	"github.com/blocktree/go-owcdrivers/addressEncoder/bech32"
+)
+
+// encodeIOTA gives the bech32 of the address type byte, the Prefix of addresstype, followed by hash.
+// Unlike a segwit address the type byte is part of the 8-bit data, not a 5-bit version group.
+func encodeIOTA(hash []byte, addresstype AddressType) string {
+	if len(hash) != addresstype.HashLen || len(addresstype.Prefix) != 1 {
+		return ""
+	}
+	return bech32.Encode(addresstype.ChecksumType, addresstype.Alphabet, catData(addresstype.Prefix, hash), nil)
+}
+
+// DecodeIOTA decodes an iota or shimmer address with the human readable part of addresstype and returns
+// the address type byte (0 for ed25519, 8 for alias and 16 for nft addresses) and the 32-byte hash or id after it.
+// Any type byte is accepted, AddressDecode only accepts the one of addresstype.
+func DecodeIOTA(address string, addresstype AddressType) (byte, []byte, error) {
+	if !bech32HRPMatches(address, addresstype.ChecksumType) {
+		return 0, nil, ErrorInvalidAddress
+	}
+	// iota addresses only use the bech32 checksum of BIP-173
+	_, groups, variant, err := bech32.DecodeRawVariant(address)
+	if err != nil || variant != bech32.VariantBech32 {
+		return 0, nil, ErrorInvalidAddress
+	}
+	data, err := bech32.ConvertBits(groups, 5, 8, false)
+	if err != nil || len(data) < 1 {
+		return 0, nil, ErrorInvalidAddress
+	}
+	if err := checkLength(len(data)-1, addresstype); err != nil {
+		return 0, nil, err
+	}
+	return data[0], data[1:], nil
+}
+
+func decodeIOTA(address string, addresstype AddressType) ([]byte, error) {
+	kind, hash, err := DecodeIOTA(address, addresstype)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal([]byte{kind}, addresstype.Prefix) {
+		return nil, ErrorInvalidAddress
+	}
+	return hash, nil
+}
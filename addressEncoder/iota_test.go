@@ -0,0 +1,64 @@
+package addressEncoder
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/blocktree/go-owcdrivers/addressEncoder/bech32"
+)
+
+func Test_IOTA_address(t *testing.T) {
+	// the TIP-31 ed25519 vector
+	pubkey, _ := hex.DecodeString("6f1581709bb7b1ef030d210db18e3b0ba1c776fba65d8cdaad05415142d189f8")
+	hash := "efdc112efe262b304bcf379b26c31bad029f616ee3ec4aa6345a366e4c9e43a3"
+
+	tests := map[string]AddressType{
+		"iota1qrhacyfwlcnzkvzteumekfkrrwks98mpdm37cj4xx3drvmjvnep6xqgyzyx": IOTA_mainnetAddressEd25519,
+		"atoi1qrhacyfwlcnzkvzteumekfkrrwks98mpdm37cj4xx3drvmjvnep6x8x4r7t": IOTA_testnetAddressEd25519,
+		"smr1qrhacyfwlcnzkvzteumekfkrrwks98mpdm37cj4xx3drvmjvnep6xhcazjh":  SMR_mainnetAddressEd25519,
+		"rms1qrhacyfwlcnzkvzteumekfkrrwks98mpdm37cj4xx3drvmjvnep6xrlkcfw":  SMR_testnetAddressEd25519,
+	}
+	for address, addresstype := range tests {
+		ret, err := GenerateAddress(pubkey, addresstype)
+		if err != nil || ret != address {
+			t.Errorf("%s: generated %s, %v", addresstype.ChecksumType, ret, err)
+		}
+		data, err := AddressDecode(address, addresstype)
+		if err != nil || hex.EncodeToString(data) != hash {
+			t.Errorf("%s: decoded %x, %v", address, data, err)
+		}
+		kind, data, err := DecodeIOTA(address, addresstype)
+		if err != nil || kind != 0 || hex.EncodeToString(data) != hash {
+			t.Errorf("%s: decoded type %d %x, %v", address, kind, data, err)
+		}
+	}
+
+	if _, err := AddressDecode("smr1qrhacyfwlcnzkvzteumekfkrrwks98mpdm37cj4xx3drvmjvnep6xhcazjh", IOTA_mainnetAddressEd25519); err == nil {
+		t.Error("shimmer address decoded as iota")
+	}
+
+	// an alias address, type 8, is not an ed25519 one
+	id, _ := hex.DecodeString(hash)
+	alias := IOTA_mainnetAddressEd25519
+	alias.Prefix = []byte{0x08}
+	address := AddressEncode(id, alias)
+	kind, data, err := DecodeIOTA(address, IOTA_mainnetAddressEd25519)
+	if err != nil || kind != 8 || hex.EncodeToString(data) != hash {
+		t.Errorf("alias address %s: decoded type %d %x, %v", address, kind, data, err)
+	}
+	if _, err := AddressDecode(address, IOTA_mainnetAddressEd25519); err != ErrorInvalidAddress {
+		t.Error("alias address decoded as ed25519:", err)
+	}
+
+	// a 20-byte hash with a valid checksum
+	short := bech32.Encode("iota", BTCBech32Alphabet, catData([]byte{0x00}, id[:20]), nil)
+	if _, err := AddressDecode(short, IOTA_mainnetAddressEd25519); err != ErrorInvalidHashLength {
+		t.Error("20 bytes hash decoded:", err)
+	}
+
+	// the same data with a bech32m checksum
+	bech32m := bech32.EncodeWithVariant("iota", BTCBech32Alphabet, catData([]byte{0x00}, id), nil, bech32.VariantBech32m)
+	if _, _, err := DecodeIOTA(bech32m, IOTA_mainnetAddressEd25519); err != ErrorInvalidAddress {
+		t.Error("bech32m checksum decoded:", err)
+	}
+}
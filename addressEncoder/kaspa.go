@@ -0,0 +1,65 @@
+package addressEncoder
+
+import (
+	"strings"
+
+	"github.com/blocktree/go-owcdrivers/addressEncoder/base32PolyMod"
+)
+
+// kaspa address versions, the first payload byte
+const (
+	KaspaPubKey      = 0x00
+	KaspaPubKeyECDSA = 0x01
+	KaspaScriptHash  = 0x08
+)
+
+// kaspaPayloadLengths gives the length of the key or script hash each kaspa version carries
+var kaspaPayloadLengths = map[byte]int{
+	KaspaPubKey:      32,
+	KaspaPubKeyECDSA: 33,
+	KaspaScriptHash:  32,
+}
+
+// encodeKAS gives the kaspa address of the version byte, the Prefix of addresstype, followed by hash.
+// Kaspa uses the cashaddr charset and polymod, but the version byte is put in the payload as it is.
+func encodeKAS(hash []byte, addresstype AddressType) string {
+	if len(hash) != addresstype.HashLen || len(addresstype.Prefix) != 1 {
+		return ""
+	}
+	return base32PolyMod.EncodePayload(addresstype.ChecksumType, addresstype.Alphabet, catData(addresstype.Prefix, hash))
+}
+
+// DecodeKaspa decodes a kaspa address with the prefix of addresstype, such as "kaspa" or "kaspatest", and returns
+// its version (KaspaPubKey, KaspaPubKeyECDSA or KaspaScriptHash) and the public key or script hash after it.
+// Any of the versions is accepted, AddressDecode only accepts the one of addresstype.
+func DecodeKaspa(address string, addresstype AddressType) (byte, []byte, error) {
+	if !strings.HasPrefix(strings.ToLower(address), addresstype.ChecksumType+":") {
+		return 0, nil, ErrorInvalidAddress
+	}
+	payload, err := base32PolyMod.DecodePayload(address, addresstype.Alphabet)
+	if err != nil {
+		return 0, nil, ErrorInvalidAddress
+	}
+	l, ok := kaspaPayloadLengths[payload[0]]
+	if !ok {
+		return 0, nil, ErrorInvalidAddress
+	}
+	if len(payload)-1 != l {
+		return 0, nil, ErrorInvalidHashLength
+	}
+	return payload[0], payload[1:], nil
+}
+
+func decodeKAS(address string, addresstype AddressType) ([]byte, error) {
+	version, data, err := DecodeKaspa(address, addresstype)
+	if err != nil {
+		return nil, err
+	}
+	if len(addresstype.Prefix) != 1 || version != addresstype.Prefix[0] {
+		return nil, ErrorInvalidAddress
+	}
+	if err := checkLength(len(data), addresstype); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
@@ -0,0 +1,67 @@
+package addressEncoder
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+func Test_KAS_address(t *testing.T) {
+	vectors := []struct {
+		address     string
+		data        string
+		addresstype AddressType
+	}{
+		{"kaspa:qqkqkzjvr7zwxxmjxjkmxxdwju9kjs6e9u82uh59z07vgaks6gg62v8707g73", "2c0b0a4c1f84e31b7234adb319ae970b6943592f0eae5e8513fcc476d0d211a5", KAS_mainnetAddressSchnorr},
+		{"kaspa:qpauqsvk7yf9unexwmxsnmg547mhyga37csh0kj53q6xxgl24ydxjsgzthw5j", "7bc04196f1125e4f2676cd09ed14afb77223b1f62177da5488346323eaa91a69", KAS_mainnetAddressSchnorr},
+		{"kaspa:precqv0krj3r6uyyfa36ga7s0u9jct0v4wg8ctsfde2gkrsgwgw8jgxfzfc98", "f38031f61ca23d70844f63a477d07f0b2c2decab907c2e096e548b0e08721c79", KAS_mainnetAddressP2SH},
+		{"kaspatest:qqnapngv3zxp305qf06w6hpzmyxtx2r99jjhs04lu980xdyd2ulwwmx9evrfz", "27d0cd0c888c18be804bf4ed5c22d90cb328652ca5783ebfe14ef3348d573ee7", KAS_testnetAddressSchnorr},
+		// computed with an independent implementation, the compressed public key of private key 1
+		{"kaspa:qyp8n0nx0muaewav2ksx99wwsu9swq5mlndjmn3gm9vl9q2mzmup0xqyr5q6q2p", "0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798", KAS_mainnetAddressECDSA},
+	}
+	for _, v := range vectors {
+		data, _ := hex.DecodeString(v.data)
+		if ret := AddressEncode(data, v.addresstype); ret != v.address {
+			t.Errorf("encode %s: got %s, want %s", v.data, ret, v.address)
+		}
+		ret, err := AddressDecode(v.address, v.addresstype)
+		if err != nil || !bytes.Equal(ret, data) {
+			t.Errorf("decode %s: got %x, %v", v.address, ret, err)
+		}
+		if ret, err := AddressDecode(strings.ToUpper(v.address), v.addresstype); err != nil || !bytes.Equal(ret, data) {
+			t.Errorf("decode upper case %s: got %x, %v", v.address, ret, err)
+		}
+		if min, max, err := ExpectedLength(v.addresstype); err != nil || min != len(v.address) || max != len(v.address) {
+			t.Errorf("expected length of %s: %d, %d, %v", v.address, min, max, err)
+		}
+	}
+
+	version, key, err := DecodeKaspa(vectors[2].address, KAS_mainnetAddressSchnorr)
+	if err != nil || version != KaspaScriptHash || hex.EncodeToString(key) != vectors[2].data {
+		t.Errorf("DecodeKaspa: %d, %x, %v", version, key, err)
+	}
+	// the version must be the one of the preset, the prefix must be there and be the network's
+	if _, err := AddressDecode(vectors[2].address, KAS_mainnetAddressSchnorr); err != ErrorInvalidAddress {
+		t.Error("script hash address decoded as a public key:", err)
+	}
+	if _, err := AddressDecode(vectors[0].address[len("kaspa:"):], KAS_mainnetAddressSchnorr); err != ErrorInvalidAddress {
+		t.Error("address without prefix:", err)
+	}
+	if _, err := AddressDecode(vectors[3].address, KAS_mainnetAddressSchnorr); err != ErrorInvalidAddress {
+		t.Error("testnet address decoded on mainnet:", err)
+	}
+	if _, err := AddressDecode(vectors[0].address[:len(vectors[0].address)-1]+"q", KAS_mainnetAddressSchnorr); err != ErrorInvalidAddress {
+		t.Error("broken checksum:", err)
+	}
+	// a cashaddr version byte is not what kaspa puts in front of the key
+	if _, err := AddressDecode("bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a", KAS_mainnetAddressSchnorr.WithHRP("bitcoincash")); err != ErrorInvalidHashLength {
+		t.Error("20-byte payload:", err)
+	}
+
+	// the script hash is the blake2b-256 of the script
+	script := []byte{0x51}
+	if ret := EncodeScriptHash(script, KAS_mainnetAddressP2SH); ret != AddressEncode(calcHash(script, "blake2b256"), KAS_mainnetAddressP2SH) || ret == "" {
+		t.Error("script hash address:", ret)
+	}
+}
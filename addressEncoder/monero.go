@@ -0,0 +1,44 @@
+package addressEncoder
+
+// EncodeMoneroIntegrated encodes the mainnet integrated address of the 32-byte public spend and view keys
+// and the 8-byte payment id, which follows the two keys: 0x13||spend||view||payment id||checksum.
+func EncodeMoneroIntegrated(spendPub, viewPub []byte, paymentID [8]byte) (string, error) {
+	if len(spendPub) != 32 || len(viewPub) != 32 {
+		return "", ErrorInvalidPubKey
+	}
+	data := catData(catData(spendPub, viewPub), paymentID[:])
+	address := AddressEncode(data, XMR_mainnetPublicIntegratedAddress)
+	if address == "" {
+		return "", ErrorInvalidAddress
+	}
+	return address, nil
+}
+
+// EncodeMoneroSubaddress encodes the mainnet subaddress of a 32-byte public spend and view key pair derived
+// from the wallet keys: 0x2A||spend||view||checksum. The keys are taken as they are and not checked to be
+// curve points, so any derived one-time key pair can be encoded.
+func EncodeMoneroSubaddress(spendPub, viewPub []byte) (string, error) {
+	if len(spendPub) != 32 || len(viewPub) != 32 {
+		return "", ErrorInvalidPubKey
+	}
+	address := AddressEncode(catData(spendPub, viewPub), XMR_mainnetPublicSubAddress)
+	if address == "" {
+		return "", ErrorInvalidAddress
+	}
+	return address, nil
+}
+
+// DecodeMoneroIntegrated decodes a mainnet integrated address into the public spend and view keys
+// and the payment id.
+func DecodeMoneroIntegrated(address string) ([]byte, []byte, [8]byte, error) {
+	var paymentID [8]byte
+	data, err := AddressDecode(address, XMR_mainnetPublicIntegratedAddress)
+	if err != nil {
+		return nil, nil, paymentID, err
+	}
+	if len(data) != XMR_mainnetPublicIntegratedAddress.HashLen {
+		return nil, nil, paymentID, ErrorInvalidHashLength
+	}
+	copy(paymentID[:], data[64:])
+	return data[:32], data[32:64], paymentID, nil
+}
@@ -0,0 +1,54 @@
+package addressEncoder
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func Test_MoneroIntegrated(t *testing.T) {
+	// the integrated form of 4AdUndXHHZ6cfufTMvppY6JwXNouMBzSkbLYfpAV5Usx3skxNgYeYTRj5UzqtReoS44qo9mtmXCqY45DJ852K5Jv2684Rge
+	address := "4LL9oSLmtpccfufTMvppY6JwXNouMBzSkbLYfpAV5Usx3skxNgYeYTRj5UzqtReoS44qo9mtmXCqY45DJ852K5Jv2bYXZKKQePHES9khPK"
+	spend, _ := hex.DecodeString("eda9fe8dfcdd25d5430ea64229d04f6b41b2e5a1587c29cd499a63eb79d11711")
+	view, _ := hex.DecodeString("3076a02b73d130fb904c9e91075fcd16f735c6850dfadb125eb826d96a113f09")
+	var paymentID [8]byte
+	pid, _ := hex.DecodeString("8a125052fe6f3877")
+	copy(paymentID[:], pid)
+
+	ret, err := EncodeMoneroIntegrated(spend, view, paymentID)
+	if err != nil || ret != address {
+		t.Errorf("encoded %s, %v", ret, err)
+	}
+
+	spendRet, viewRet, pidRet, err := DecodeMoneroIntegrated(address)
+	if err != nil || hex.EncodeToString(spendRet) != hex.EncodeToString(spend) ||
+		hex.EncodeToString(viewRet) != hex.EncodeToString(view) || pidRet != paymentID {
+		t.Errorf("decoded %x %x %x, %v", spendRet, viewRet, pidRet, err)
+	}
+
+	// the standard address of the same keys is no integrated one
+	if _, _, _, err := DecodeMoneroIntegrated("4AdUndXHHZ6cfufTMvppY6JwXNouMBzSkbLYfpAV5Usx3skxNgYeYTRj5UzqtReoS44qo9mtmXCqY45DJ852K5Jv2684Rge"); err == nil {
+		t.Error("standard address decoded as integrated")
+	}
+	if _, err := EncodeMoneroIntegrated(spend[:31], view, paymentID); err != ErrorInvalidPubKey {
+		t.Error("short spend key encoded:", err)
+	}
+}
+
+func Test_MoneroSubaddress(t *testing.T) {
+	// synthetic one-time keys, sha256 of "one-time spend" and "one-time view"
+	spend, _ := hex.DecodeString("731ab742b069e0f5f059afc0a7b1547ae8a6039e05e5515c75339c5dc139ae86")
+	view, _ := hex.DecodeString("494a816e1663a203a4fb3bde4e7f9e265428ff484729d968ef8e19ccb0ada20c")
+	address := "86pF9cGt48Pi8v5Q1HeuLoMZNQrGiZxgGGTxVCjXuVHXPTkE3bYv1hT1cMdxNWMAj77QqYJsqwSDnJZ1V4AKVgUh2U1ykdP"
+
+	ret, err := EncodeMoneroSubaddress(spend, view)
+	if err != nil || ret != address {
+		t.Errorf("encoded %s, %v", ret, err)
+	}
+	data, err := AddressDecode(address, XMR_mainnetPublicSubAddress)
+	if err != nil || hex.EncodeToString(data) != hex.EncodeToString(catData(spend, view)) {
+		t.Errorf("decoded %x, %v", data, err)
+	}
+	if _, err := EncodeMoneroSubaddress(spend, view[:31]); err != ErrorInvalidPubKey {
+		t.Error("short view key encoded:", err)
+	}
+}
@@ -0,0 +1,63 @@
+package addressEncoder
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+)
+
+var (
+	ErrorUnknownURIScheme = errors.New("Unknown payment URI scheme!")
+)
+
+// paymentURISchemes are the URI schemes ParsePaymentURI takes, BIP-21 and the litecoin and EIP-681 ones built on it
+var paymentURISchemes = []string{"bitcoin", "litecoin", "ethereum"}
+
+// ParsePaymentURI extracts the address and the query parameters of a payment URI such as
+// "bitcoin:bc1q...?amount=0.1&label=Shop", the parameters percent decoded. The scheme is matched in any case.
+// The address is not decoded, pass it to AddressDecode with the type it should be. For ethereum URIs
+// the chain id after @ and the function after / are dropped from the address.
+func ParsePaymentURI(uri string) (string, map[string]string, error) {
+	pos := strings.IndexByte(uri, ':')
+	if pos < 0 {
+		return "", nil, ErrorUnknownURIScheme
+	}
+	scheme := strings.ToLower(uri[:pos])
+	known := false
+	for _, s := range paymentURISchemes {
+		known = known || s == scheme
+	}
+	if !known {
+		return "", nil, ErrorUnknownURIScheme
+	}
+
+	address, query := uri[pos+1:], ""
+	if i := strings.IndexByte(address, '?'); i >= 0 {
+		address, query = address[:i], address[i+1:]
+	}
+	// some wallets write bitcoin://address
+	address = strings.TrimPrefix(address, "//")
+	if scheme == "ethereum" {
+		address = strings.TrimPrefix(address, "pay-")
+		if i := strings.IndexAny(address, "@/"); i >= 0 {
+			address = address[:i]
+		}
+	}
+	if address == "" {
+		return "", nil, ErrorInvalidAddress
+	}
+
+	values, err := url.ParseQuery(query)
+	if err != nil {
+		return "", nil, ErrorInvalidAddress
+	}
+	params := make(map[string]string, len(values))
+	for key, v := range values {
+		// BIP-21 allows each parameter once
+		if len(v) > 1 {
+			return "", nil, ErrorInvalidAddress
+		}
+		params[key] = v[0]
+	}
+	return address, params, nil
+}
@@ -0,0 +1,45 @@
+package addressEncoder
+
+import (
+	"testing"
+)
+
+func Test_ParsePaymentURI(t *testing.T) {
+	address, params, err := ParsePaymentURI("bitcoin:bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4?amount=0.1&label=Luke-Jr%20Shop&message=Donation")
+	if err != nil || address != "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4" {
+		t.Fatalf("got %s, %v", address, err)
+	}
+	if params["amount"] != "0.1" || params["label"] != "Luke-Jr Shop" || params["message"] != "Donation" || len(params) != 3 {
+		t.Errorf("params %v", params)
+	}
+	if _, err := AddressDecode(address, BTC_mainnetAddressBech32V0); err != nil {
+		t.Error("extracted address does not decode:", err)
+	}
+
+	tests := map[string]string{
+		"bitcoin:1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2":                                 "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2",
+		"BITCOIN:BC1QW508D6QEJXTDG4Y5R3ZARVARY0C5XW7KV8F3T4?amount=1":                "BC1QW508D6QEJXTDG4Y5R3ZARVARY0C5XW7KV8F3T4",
+		"bitcoin://1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2":                               "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2",
+		"litecoin:ltc1qg42tkwuuxefutzxezdkdel39gfstuap288mfea?amount=2":              "ltc1qg42tkwuuxefutzxezdkdel39gfstuap288mfea",
+		"ethereum:0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed@1?value=1e18":           "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		"ethereum:pay-0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed/transfer?uint256=1": "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+	}
+	for uri, expect := range tests {
+		if address, _, err := ParsePaymentURI(uri); err != nil || address != expect {
+			t.Errorf("%s: got %s, %v", uri, address, err)
+		}
+	}
+
+	invalid := map[string]error{
+		"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2":                           ErrorUnknownURIScheme,
+		"dogecoin:DH5yaieqoZN36fDVciNyRueRGvGLR3mr7L":                  ErrorUnknownURIScheme,
+		"bitcoin:?amount=1":                                            ErrorInvalidAddress,
+		"bitcoin:1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2?amount=1&amount=2": ErrorInvalidAddress,
+		"bitcoin:1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2?label=%zz":         ErrorInvalidAddress,
+	}
+	for uri, expect := range invalid {
+		if _, _, err := ParsePaymentURI(uri); err != expect {
+			t.Errorf("%s: got %v, want %v", uri, err, expect)
+		}
+	}
+}
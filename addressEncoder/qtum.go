@@ -0,0 +1,25 @@
+package addressEncoder
+
+import (
+	"encoding/hex"
+
+	"github.com/blocktree/go-owcrypt"
+)
+
+// QtumContractAddress computes the address of a qtum EVM contract created by output vout of transaction txid,
+// hash160(txid ++ vout) with txid in its serialized byte order and vout as 4 little endian bytes.
+// txid is given in the usual display order, and the address is returned as 40 lowercase hex characters.
+func QtumContractAddress(txid []byte, vout uint32) (string, error) {
+	if len(txid) != 32 {
+		return "", ErrorInvalidHashLength
+	}
+	data := make([]byte, 36)
+	for i := 0; i < 32; i++ {
+		data[i] = txid[31-i]
+	}
+	data[32] = byte(vout)
+	data[33] = byte(vout >> 8)
+	data[34] = byte(vout >> 16)
+	data[35] = byte(vout >> 24)
+	return hex.EncodeToString(owcrypt.Hash(data, 0, owcrypt.HASH_ALG_HASH160)), nil
+}
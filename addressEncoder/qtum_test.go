@@ -0,0 +1,59 @@
+package addressEncoder
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/blocktree/go-owcrypt"
+)
+
+func Test_QTUM_address(t *testing.T) {
+	vectors := []struct {
+		addresstype AddressType
+		address     string
+		hash        string
+	}{
+		{QTUM_mainnetAddressP2PKH, "QQfTuAKdRrTawjiPZRcQ6iaK9BgxwMDgXN", "2c88f3163a4a308dd024080d6f822a23d47f3229"},
+		{QTUM_mainnetAddressP2PKH, "QiZtY5ssbVis9MntBdqmcYuJWsP5BCGBX3", "f0ed48938dfa7dea31c4d12a1461b9f77560500e"},
+		{QTUM_mainnetAddressP2PKH, "Qiqk8a4ezUci9s6xeoBZHMTE1CtyjKJhNq", "f3ecec22a336e205f6fbcb95ea459b6ed859a04f"},
+	}
+	for _, v := range vectors {
+		ret, err := AddressDecode(v.address, v.addresstype)
+		if err != nil || hex.EncodeToString(ret) != v.hash {
+			t.Errorf("decode %s: got %x, %v", v.address, ret, err)
+			continue
+		}
+		if address := AddressEncode(ret, v.addresstype); address != v.address {
+			t.Errorf("encode %s: got %s", v.hash, address)
+		}
+	}
+
+	// the P2PKH address of a compressed WIF key
+	wif, err := AddressDecode("KxRGsMrnSRhcjmKDeajpWQXQi6agP8WiJ19djdGQ8gdWmzAsTFBe", QTUM_mainnetPrivateWIFCompressed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pub, _ := owcrypt.GenPubkey(wif, owcrypt.ECC_CURVE_SECP256K1)
+	pub = owcrypt.PointCompress(pub, owcrypt.ECC_CURVE_SECP256K1)
+	if address := AddressEncode(pub, QTUM_mainnetAddressP2PKH); address != "Qiqk8a4ezUci9s6xeoBZHMTE1CtyjKJhNq" {
+		t.Errorf("wif public key address wrong: %s", address)
+	}
+
+	hash, _ := hex.DecodeString("2c88f3163a4a308dd024080d6f822a23d47f3229")
+	if _, err := AddressDecode(AddressEncode(hash, QTUM_mainnetAddressP2SH), QTUM_mainnetAddressP2PKH); err == nil {
+		t.Error("p2sh address should not decode as p2pkh")
+	}
+}
+
+func Test_QtumContractAddress(t *testing.T) {
+	txid, _ := hex.DecodeString("6b34a40bd8a1d6c26f01bb37bbe0e7ae2610b66bb3ed0d39a4bbaf0dc3ee9b30")
+	for vout, expect := range []string{"63a02214c5b4f207b2d2a9349ca9a4b43ca9fd0a", "7c0d052b4b76f79909cfa70930bbdfc9c82919dc"} {
+		ret, err := QtumContractAddress(txid, uint32(vout))
+		if err != nil || ret != expect {
+			t.Errorf("vout %d: contract address %s, want %s", vout, ret, expect)
+		}
+	}
+	if _, err := QtumContractAddress(txid[:31], 0); err == nil {
+		t.Error("short txid should be rejected")
+	}
+}
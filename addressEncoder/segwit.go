@@ -0,0 +1,38 @@
+package addressEncoder
+
+import (
+	"github.com/blocktree/go-owcdrivers/addressEncoder/bech32"
+)
+
+// witnessVariant returns the checksum variant BIP-350 gives witness version
+func witnessVariant(version int) string {
+	if version == 0 {
+		return bech32.VariantBech32
+	}
+	return bech32.VariantBech32m
+}
+
+// EncodeWitness encodes a segwit address of any witness version with the human readable part hrp,
+// bech32 for version 0 and bech32m for versions 1 to 16. Version 0 programs must be 20 or 32 bytes,
+// later versions take any program of 2 to 40 bytes, so outputs defined after this code, such as
+// pay to anchor, can be encoded as well.
+func EncodeWitness(hrp string, version int, program []byte) (string, error) {
+	if _, err := bech32.WitnessKind(version, program, witnessVariant(version)); err != nil {
+		return "", ErrorInvalidHashLength
+	}
+	return bech32.EncodeWithVariant(hrp, BTCBech32Alphabet, program, []byte{byte(version)}, witnessVariant(version)), nil
+}
+
+// DecodeGeneric decodes a segwit address with the human readable part hrp and returns the witness version
+// and program as they are, leaving it to the caller to tell what they mean. Unlike the presets it accepts
+// any version and program length BIP-141 and BIP-350 allow, checksummed with the variant of the version.
+func DecodeGeneric(address, hrp string) (int, []byte, error) {
+	if !bech32HRPMatches(address, hrp) {
+		return 0, nil, ErrorInvalidAddress
+	}
+	version, program, _, err := bech32.DecodeSegwit(address, BTCBech32Alphabet)
+	if err != nil {
+		return 0, nil, ErrorInvalidAddress
+	}
+	return version, program, nil
+}
@@ -0,0 +1,50 @@
+package addressEncoder
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func Test_EncodeWitness(t *testing.T) {
+	tests := []struct {
+		address string
+		version int
+		program string
+	}{
+		{"bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4", 0, "751e76e8199196d454941c45d1b3a323f1433bd6"},
+		{"bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj0", 1, "79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"},
+		// pay to anchor, a 2-byte version 1 program
+		{"bc1pfeessrawgf", 1, "4e73"},
+		// a version 2 output no one has defined yet
+		{"bc1zfeesuqhy82", 2, "4e73"},
+		{"bc1sw50qgdz25j", 16, "751e"},
+	}
+	for _, test := range tests {
+		program, _ := hex.DecodeString(test.program)
+		address, err := EncodeWitness("bc", test.version, program)
+		if err != nil || address != test.address {
+			t.Errorf("version %d %s: encoded %s, %v", test.version, test.program, address, err)
+		}
+		version, ret, err := DecodeGeneric(test.address, "bc")
+		if err != nil || version != test.version || hex.EncodeToString(ret) != test.program {
+			t.Errorf("%s: decoded %d %x, %v", test.address, version, ret, err)
+		}
+	}
+
+	for _, test := range []struct {
+		version int
+		size    int
+	}{{0, 16}, {1, 1}, {2, 41}, {17, 20}, {-1, 20}} {
+		if address, err := EncodeWitness("bc", test.version, make([]byte, test.size)); err == nil {
+			t.Errorf("version %d with %d bytes encoded as %s", test.version, test.size, address)
+		}
+	}
+
+	if _, _, err := DecodeGeneric("bc1zfeesuqhy82", "tb"); err == nil {
+		t.Error("mainnet address decoded for testnet")
+	}
+	// version 2 checksummed as bech32
+	if _, _, err := DecodeGeneric("bc1zw508d6qejxtdg4y5r3zarvaryvqyzf3du", "bc"); err == nil {
+		t.Error("bech32 checksum accepted for version 2")
+	}
+}
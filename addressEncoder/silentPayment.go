@@ -0,0 +1,59 @@
+package addressEncoder
+
+import (
+	"github.com/blocktree/go-owcdrivers/addressEncoder/bech32"
+)
+
+// silentPaymentHRPs are the human readable parts of BIP-352 silent payment addresses on each network
+var silentPaymentHRPs = map[string]string{
+	"mainnet": "sp",
+	"testnet": "tsp",
+}
+
+// silentPaymentMaxLength is the longest silent payment address BIP-352 allows
+const silentPaymentMaxLength = 1023
+
+// EncodeSilentPayment encodes the version 0 silent payment address of the compressed scan and spend
+// public keys on network ("mainnet" or "testnet").
+func EncodeSilentPayment(scanPub, spendPub []byte, network string) (string, error) {
+	hrp, ok := silentPaymentHRPs[network]
+	if !ok {
+		return "", ErrorNetworkType
+	}
+	if len(scanPub) != 33 || len(spendPub) != 33 || !isPublicKey(scanPub) || !isPublicKey(spendPub) {
+		return "", ErrorInvalidPubKey
+	}
+	return bech32.EncodeWithVariant(hrp, BTCBech32Alphabet, catData(scanPub, spendPub), []byte{0}, bech32.VariantBech32m), nil
+}
+
+// DecodeSilentPayment decodes a BIP-352 silent payment address (sp1... or tsp1...) and returns the scan
+// and spend public keys. Version 0 carries exactly the two 33-byte keys; later versions may append data,
+// which is ignored as BIP-352 asks, and version 31 is reserved.
+func DecodeSilentPayment(address string) ([]byte, []byte, error) {
+	if len(address) > silentPaymentMaxLength {
+		return nil, nil, ErrorInvalidAddress
+	}
+	hrp, groups, variant, err := bech32.DecodeRawVariant(address)
+	if err != nil || variant != bech32.VariantBech32m || len(groups) < 1 {
+		return nil, nil, ErrorInvalidAddress
+	}
+	if hrp != silentPaymentHRPs["mainnet"] && hrp != silentPaymentHRPs["testnet"] {
+		return nil, nil, ErrorInvalidAddress
+	}
+	version := groups[0]
+	if version == 31 {
+		return nil, nil, ErrorInvalidAddress
+	}
+	payload, err := bech32.ConvertBits(groups[1:], 5, 8, false)
+	if err != nil {
+		return nil, nil, ErrorInvalidAddress
+	}
+	if len(payload) < 66 || (version == 0 && len(payload) != 66) {
+		return nil, nil, ErrorInvalidHashLength
+	}
+	scanPub, spendPub := payload[:33], payload[33:66]
+	if !isPublicKey(scanPub) || !isPublicKey(spendPub) {
+		return nil, nil, ErrorInvalidPubKey
+	}
+	return scanPub, spendPub, nil
+}
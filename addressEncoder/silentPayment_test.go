@@ -0,0 +1,59 @@
+package addressEncoder
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func Test_DecodeSilentPayment(t *testing.T) {
+	// the BIP-352 receiving vector
+	address := "sp1qqgste7k9hx0qftg6qmwlkqtwuy6cycyavzmzj85c6qdfhjdpdjtdgqjuexzk6murw56suy3e0rd2cgqvycxttddwsvgxe2usfpxumr70xc9pkqwv"
+	scan := "0220bcfac5b99e04ad1a06ddfb016ee13582609d60b6291e98d01a9bc9a16c96d4"
+	spend := "025cc9856d6f8375350e123978daac200c260cb5b5ae83106cab90484dcd8fcf36"
+
+	tests := map[string]string{
+		address: "mainnet",
+		"tsp1qqgste7k9hx0qftg6qmwlkqtwuy6cycyavzmzj85c6qdfhjdpdjtdgqjuexzk6murw56suy3e0rd2cgqvycxttddwsvgxe2usfpxumr70xc3wk4yh": "testnet",
+	}
+	for address, network := range tests {
+		scanPub, spendPub, err := DecodeSilentPayment(address)
+		if err != nil || hex.EncodeToString(scanPub) != scan || hex.EncodeToString(spendPub) != spend {
+			t.Errorf("%s: decoded %x %x, %v", address, scanPub, spendPub, err)
+			continue
+		}
+		if ret, err := EncodeSilentPayment(scanPub, spendPub, network); err != nil || ret != address {
+			t.Errorf("%s: encoded %s, %v", network, ret, err)
+		}
+	}
+
+	// a later version with two more bytes, which are ignored
+	scanPub, spendPub, err := DecodeSilentPayment("sp1pqgste7k9hx0qftg6qmwlkqtwuy6cycyavzmzj85c6qdfhjdpdjtdgqjuexzk6murw56suy3e0rd2cgqvycxttddwsvgxe2usfpxumr70x6veskvhk59")
+	if err != nil || hex.EncodeToString(scanPub) != scan || hex.EncodeToString(spendPub) != spend {
+		t.Errorf("version 1: decoded %x %x, %v", scanPub, spendPub, err)
+	}
+
+	invalid := map[string]error{
+		// version 0 with a byte too many
+		"sp1qqgste7k9hx0qftg6qmwlkqtwuy6cycyavzmzj85c6qdfhjdpdjtdgqjuexzk6murw56suy3e0rd2cgqvycxttddwsvgxe2usfpxumr70x6vstctef4": ErrorInvalidHashLength,
+		// and a byte short
+		"sp1qqgste7k9hx0qftg6qmwlkqtwuy6cycyavzmzj85c6qdfhjdpdjtdgqjuexzk6murw56suy3e0rd2cgqvycxttddwsvgxe2usfpxumr70kll9qy": ErrorInvalidHashLength,
+		// the reserved version 31
+		"sp1lqgste7k9hx0qftg6qmwlkqtwuy6cycyavzmzj85c6qdfhjdpdjtdgqjuexzk6murw56suy3e0rd2cgqvycxttddwsvgxe2usfpxumr70xc4wndsd": ErrorInvalidAddress,
+		// bech32 checksum
+		"sp1qqgste7k9hx0qftg6qmwlkqtwuy6cycyavzmzj85c6qdfhjdpdjtdgqjuexzk6murw56suy3e0rd2cgqvycxttddwsvgxe2usfpxumr70xcsaxvtw": ErrorInvalidAddress,
+		"bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj0":                                                       ErrorInvalidAddress,
+	}
+	for address, expect := range invalid {
+		if _, _, err := DecodeSilentPayment(address); err != expect {
+			t.Errorf("%s: got %v, want %v", address, err, expect)
+		}
+	}
+
+	key, _ := hex.DecodeString(scan)
+	if _, err := EncodeSilentPayment(key, key[:32], "mainnet"); err != ErrorInvalidPubKey {
+		t.Error("short spend key encoded:", err)
+	}
+	if _, err := EncodeSilentPayment(key, key, "regtest"); err != ErrorNetworkType {
+		t.Error("unknown network encoded:", err)
+	}
+}
@@ -0,0 +1,107 @@
+package addressEncoder
+
+import (
+	"errors"
+
+	"github.com/blocktree/go-owcrypt"
+)
+
+// SS58Network is the address type(network prefix) of a substrate SS58 address
+type SS58Network uint16
+
+const (
+	SS58Polkadot  SS58Network = 0
+	SS58Kusama    SS58Network = 2
+	SS58Substrate SS58Network = 42
+)
+
+var (
+	ErrorInvalidSS58Network = errors.New("Invalid SS58 network!")
+
+	ss58ChecksumPrefix = []byte("SS58PRE")
+
+	// ss58Networks names the core networks and registered parachains
+	ss58Networks = map[SS58Network]string{
+		SS58Polkadot:  "polkadot",
+		SS58Kusama:    "kusama",
+		5:             "astar",
+		6:             "bifrost",
+		7:             "edgeware",
+		8:             "karura",
+		10:            "acala",
+		36:            "centrifuge",
+		38:            "kilt",
+		SS58Substrate: "substrate",
+		1284:          "moonbeam",
+		1285:          "moonriver",
+	}
+)
+
+// Name returns the registered name of the network, empty if it is unknown
+func (n SS58Network) Name() string {
+	return ss58Networks[n]
+}
+
+// SS58NetworkByName looks up a network from its registered name
+func SS58NetworkByName(name string) (SS58Network, bool) {
+	for n, s := range ss58Networks {
+		if s == name {
+			return n, true
+		}
+	}
+	return 0, false
+}
+
+func ss58Checksum(data []byte) []byte {
+	return owcrypt.Hash(catData(ss58ChecksumPrefix, data), 64, owcrypt.HASH_ALG_BLAKE2B)[:2]
+}
+
+// ss58Prefix gives the one byte(0-63) or two byte(64-16383) encoding of the network
+func ss58Prefix(network SS58Network) ([]byte, error) {
+	if network < 64 {
+		return []byte{byte(network)}, nil
+	}
+	if network > 16383 {
+		return nil, ErrorInvalidSS58Network
+	}
+	return []byte{byte(network&0xfc>>2) | 0x40, byte(network>>8) | byte(network&0x03)<<6}, nil
+}
+
+// EncodeSS58 encodes a 32-byte public key as an SS58 address of network
+func EncodeSS58(pubkey []byte, network SS58Network) (string, error) {
+	if len(pubkey) != 32 {
+		return "", ErrorInvalidHashLength
+	}
+	prefix, err := ss58Prefix(network)
+	if err != nil {
+		return "", err
+	}
+	data := catData(prefix, pubkey)
+	return Base58Encode(catData(data, ss58Checksum(data)), NewBase58Alphabet(BTCAlphabet)), nil
+}
+
+// DecodeSS58 decodes an SS58 address, returns the public key, the network and the network's name
+// which is empty when the network is not a registered one.
+func DecodeSS58(address string) ([]byte, SS58Network, string, error) {
+	data, err := Base58Decode(address, NewBase58Alphabet(BTCAlphabet))
+	if err != nil || len(data) < 1 {
+		return nil, 0, "", ErrorInvalidAddress
+	}
+	prefixLen := 1
+	network := SS58Network(data[0])
+	if data[0]&0x40 != 0 {
+		if data[0]&0x80 != 0 || len(data) < 2 {
+			return nil, 0, "", ErrorInvalidAddress
+		}
+		prefixLen = 2
+		network = SS58Network(data[0]<<2|data[1]>>6) | SS58Network(data[1]&0x3f)<<8
+	}
+	if len(data) != prefixLen+32+2 {
+		return nil, 0, "", ErrorInvalidHashLength
+	}
+	chk := ss58Checksum(data[:len(data)-2])
+	if chk[0] != data[len(data)-2] || chk[1] != data[len(data)-1] {
+		return nil, 0, "", ErrorInvalidAddress
+	}
+	return data[prefixLen : prefixLen+32], network, network.Name(), nil
+}
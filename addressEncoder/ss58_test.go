@@ -0,0 +1,40 @@
+package addressEncoder
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func Test_SS58(t *testing.T) {
+	pubkey, _ := hex.DecodeString("d43593c715fdd31c61141abd04a99fd6822c8558854ccde39a5684e7a56da27d")
+	vectors := []struct {
+		network SS58Network
+		name    string
+		address string
+	}{
+		{SS58Polkadot, "polkadot", "15oF4uVJwmo4TdGW7VfQxNLavjCXviqxT9S1MgbjMNHr6Sp5"},
+		{SS58Kusama, "kusama", "HNZata7iMYWmk5RvZRTiAsSDhV8366zq2YGb3tLH5Upf74F"},
+		{SS58Substrate, "substrate", "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY"},
+		{1284, "moonbeam", "VdvKmYJfD4VXA9fzz1SbmCo2eYHSzUFbaDCZSuaNKJAe8YNg6"},
+	}
+	for _, v := range vectors {
+		address, err := EncodeSS58(pubkey, v.network)
+		if err != nil || address != v.address {
+			t.Errorf("%s address %s, want %s", v.name, address, v.address)
+		}
+		key, network, name, err := DecodeSS58(v.address)
+		if err != nil || hex.EncodeToString(key) != hex.EncodeToString(pubkey) || network != v.network || name != v.name {
+			t.Errorf("decode %s failed: %x %d %s %v", v.address, key, network, name, err)
+		}
+		if n, ok := SS58NetworkByName(v.name); !ok || n != v.network {
+			t.Errorf("network %s not found", v.name)
+		}
+	}
+
+	if _, err := EncodeSS58(pubkey, 16384); err != ErrorInvalidSS58Network {
+		t.Error("out of range network should be rejected")
+	}
+	if _, _, _, err := DecodeSS58("5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQZ"); err == nil {
+		t.Error("bad checksum should be rejected")
+	}
+}
@@ -0,0 +1,55 @@
+package addressEncoder
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/blocktree/go-owcrypt"
+)
+
+var (
+	ErrorInvalidTweak = errors.New("Invalid taproot tweak!")
+)
+
+// secp256k1P is the field prime of secp256k1
+var secp256k1P, _ = new(big.Int).SetString("fffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f", 16)
+
+// taggedHash is the BIP-340 tagged hash sha256(sha256(tag)||sha256(tag)||msg)
+func taggedHash(tag string, msg []byte) []byte {
+	tagHash := owcrypt.Hash([]byte(tag), 32, owcrypt.HASH_ALG_SHA256)
+	return owcrypt.Hash(catData(catData(tagHash, tagHash), msg), 32, owcrypt.HASH_ALG_SHA256)
+}
+
+// isXOnlyKey reports whether x is the x coordinate of a secp256k1 point, the lift_x check of BIP-340
+func isXOnlyKey(x []byte) bool {
+	px := new(big.Int).SetBytes(x)
+	if px.Cmp(secp256k1P) >= 0 {
+		return false
+	}
+	c := new(big.Int).Exp(px, big.NewInt(3), secp256k1P)
+	c.Add(c, big.NewInt(7))
+	c.Mod(c, secp256k1P)
+	return new(big.Int).ModSqrt(c, secp256k1P) != nil
+}
+
+// TweakTaprootKey computes the BIP-341 output key of the 32-byte x-only internalKey, the x coordinate of
+// P + hash_TapTweak(P||merkleRoot)G. merkleRoot is the 32-byte root of the script tree, or nil for a key path
+// only output. The returned 32 bytes are the witness program, encode them with BTC_mainnetAddressTaproot.
+func TweakTaprootKey(internalKey []byte, merkleRoot []byte) ([]byte, error) {
+	if len(internalKey) != 32 || !isXOnlyKey(internalKey) {
+		return nil, ErrorInvalidPubKey
+	}
+	if len(merkleRoot) != 0 && len(merkleRoot) != 32 {
+		return nil, ErrorInvalidHashLength
+	}
+	tweak := taggedHash("TapTweak", catData(internalKey, merkleRoot))
+	if new(big.Int).SetBytes(tweak).Cmp(new(big.Int).SetBytes(owcrypt.GetCurveOrder(owcrypt.ECC_CURVE_SECP256K1))) >= 0 {
+		return nil, ErrorInvalidTweak
+	}
+	point := owcrypt.PointDecompress(catData([]byte{0x02}, internalKey), owcrypt.ECC_CURVE_SECP256K1)[1:]
+	output, isInfinity := owcrypt.Point_mulBaseG_add(point, tweak, owcrypt.ECC_CURVE_SECP256K1)
+	if isInfinity {
+		return nil, ErrorInvalidTweak
+	}
+	return output[:32], nil
+}
@@ -0,0 +1,66 @@
+package addressEncoder
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+func Test_TweakTaprootKey(t *testing.T) {
+	// BIP-341 wallet test vectors, scriptPubKey
+	vectors := []struct {
+		internalKey string
+		merkleRoot  string
+		outputKey   string
+		address     string
+	}{
+		{"d6889cb081036e0faefa3a35157ad71086b123b2b144b649798b494c300a961d", "",
+			"53a1f6e454df1aa2776a2814a721372d6258050de330b3c6d10ee8f4e0dda343",
+			"bc1p2wsldez5mud2yam29q22wgfh9439spgduvct83k3pm50fcxa5dps59h4z5"},
+		{"187791b6f712a8ea41c8ecdd0ee77fab3e85263b37e1ec18a3651926b3a6cf27", "5b75adecf53548f3ec6ad7d78383bf84cc57b55a3127c72b9a2481752dd88b21",
+			"147c9c57132f6e7ecddba9800bb0c4449251c92a1e60371ee77557b6620f3ea3",
+			"bc1pz37fc4cn9ah8anwm4xqqhvxygjf9rjf2resrw8h8w4tmvcs0863sa2e586"},
+	}
+	for _, v := range vectors {
+		internalKey, _ := hex.DecodeString(v.internalKey)
+		merkleRoot, _ := hex.DecodeString(v.merkleRoot)
+		if len(merkleRoot) == 0 {
+			merkleRoot = nil
+		}
+		outputKey, err := TweakTaprootKey(internalKey, merkleRoot)
+		if err != nil {
+			t.Errorf("tweak %s failed: %v", v.internalKey, err)
+			continue
+		}
+		if hex.EncodeToString(outputKey) != v.outputKey {
+			t.Errorf("tweak %s: got %x, want %s", v.internalKey, outputKey, v.outputKey)
+		}
+		if address := AddressEncode(outputKey, BTC_mainnetAddressTaproot); address != v.address {
+			t.Errorf("encode %s: got %s, want %s", v.outputKey, address, v.address)
+		}
+		data, err := AddressDecode(v.address, BTC_mainnetAddressTaproot)
+		if err != nil || !bytes.Equal(data, outputKey) {
+			t.Errorf("decode %s failed: %x, %v", v.address, data, err)
+		}
+	}
+
+	internalKey, _ := hex.DecodeString(vectors[0].internalKey)
+	if _, err := TweakTaprootKey(internalKey, []byte{}); err != nil {
+		t.Errorf("empty merkle root failed: %v", err)
+	}
+	if _, err := TweakTaprootKey(internalKey[:31], nil); err != ErrorInvalidPubKey {
+		t.Errorf("short internal key: got %v", err)
+	}
+	if _, err := TweakTaprootKey(internalKey, internalKey[:31]); err != ErrorInvalidHashLength {
+		t.Errorf("short merkle root: got %v", err)
+	}
+	// x = 5 is not on the curve and x >= p is not a field element
+	notOnCurve := make([]byte, 32)
+	notOnCurve[31] = 5
+	if _, err := TweakTaprootKey(notOnCurve, nil); err != ErrorInvalidPubKey {
+		t.Errorf("x not on curve: got %v", err)
+	}
+	if _, err := TweakTaprootKey(bytes.Repeat([]byte{0xff}, 32), nil); err != ErrorInvalidPubKey {
+		t.Errorf("x above field prime: got %v", err)
+	}
+}
@@ -0,0 +1,37 @@
+package addressEncoder
+
+import "bytes"
+
+// tezos address kinds and the presets their prefix bytes come from
+var tezosKinds = []struct {
+	kind        string
+	addresstype AddressType
+}{
+	{"tz1", XTZ_mainnetAddress_tz1},
+	{"tz2", XTZ_mainnetAddress_tz2},
+	{"tz3", XTZ_mainnetAddress_tz3},
+	{"tz4", XTZ_mainnetAddress_tz4},
+	{"KT1", XTZ_mainnetAddress_KT1},
+}
+
+// TezosAddressKind decodes a tezos address of any kind and returns the kind, tz1(ed25519), tz2(secp256k1),
+// tz3(p256), tz4(BLS) or KT1(originated contract), together with the 20-byte hash.
+func TezosAddressKind(address string) (string, []byte, error) {
+	anyKind := AddressType{EncodeType: "base58", Alphabet: XTZAlphabet, ChecksumType: "doubleSHA256", HashLen: 20,
+		PrefixLen: func([]byte) int { return 3 }}
+	prefix, hash, err := AddressDecodePrefix(address, anyKind)
+	if err != nil {
+		return "", nil, err
+	}
+	for _, k := range tezosKinds {
+		if bytes.Equal(prefix, k.addresstype.Prefix) {
+			return k.kind, hash, nil
+		}
+	}
+	return "", nil, ErrorInvalidAddress
+}
+
+// TezosDecode is an alias of TezosAddressKind, which holds the implementation.
+func TezosDecode(s string) (kind string, hash []byte, err error) {
+	return TezosAddressKind(s)
+}
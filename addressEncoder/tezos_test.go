@@ -0,0 +1,66 @@
+package addressEncoder
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func Test_TezosAddressKind(t *testing.T) {
+	tests := []struct {
+		address string
+		kind    string
+		hash    string
+	}{
+		{"tz1iycVGryQop8nryZWcXfvtiK5KvxC5coUS", "tz1", "ffff01f1c3ddf8ab69832f910057e99ac8cf3bc6"},
+		{"tz2XepTVTYqAjtRjFjZTCJu9FtLLSqhWewru", "tz2", "ffff01f1c3ddf8ab69832f910057e99ac8cf3bc6"},
+		{"tz3WXYtyDUNL91qfiCJtVUX746QpNv5i5ve5", "tz3", "6fde46af0356a0476dae4e4600172dc9309b3aa4"},
+		{"tz4HVR6aty9KwsQFHh81C1G7gBdhxT8kuytm", "tz4", "5d1497f39b87599983fe8f29599b679564be822d"},
+		{"KT1PWx2mnDueood7fEmfbBDKx1D9BAnnXitn", "KT1", "a3d0f58d8964bd1b37fb0a0c197b38cf46608d49"},
+	}
+	for _, test := range tests {
+		kind, hash, err := TezosAddressKind(test.address)
+		if err != nil || kind != test.kind || hex.EncodeToString(hash) != test.hash {
+			t.Errorf("%s: got %s %x %v", test.address, kind, hash, err)
+		}
+	}
+
+	hash, _ := hex.DecodeString("a3d0f58d8964bd1b37fb0a0c197b38cf46608d49")
+	if ret := AddressEncode(hash, XTZ_mainnetAddress_KT1); ret != "KT1PWx2mnDueood7fEmfbBDKx1D9BAnnXitn" {
+		t.Error("KT1 encode wrong result:", ret)
+	}
+
+	// a bitcoin address checks out as base58check but has no tezos prefix
+	for _, address := range []string{"1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH", "tz1iycVGryQop8nryZWcXfvtiK5KvxC5coUT"} {
+		if kind, _, err := TezosAddressKind(address); err == nil {
+			t.Errorf("%s decoded as %s", address, kind)
+		}
+	}
+}
+
+func Test_TezosDecode(t *testing.T) {
+	for address, kind := range map[string]string{
+		"tz1iycVGryQop8nryZWcXfvtiK5KvxC5coUS": "tz1",
+		"tz2XepTVTYqAjtRjFjZTCJu9FtLLSqhWewru": "tz2",
+		"tz3WXYtyDUNL91qfiCJtVUX746QpNv5i5ve5": "tz3",
+		"tz4HVR6aty9KwsQFHh81C1G7gBdhxT8kuytm": "tz4",
+		"KT1PWx2mnDueood7fEmfbBDKx1D9BAnnXitn": "KT1",
+	} {
+		ret, hash, err := TezosDecode(address)
+		if err != nil || ret != kind {
+			t.Errorf("%s: got %s, %v, want %s", address, ret, err, kind)
+			continue
+		}
+		// the hash is the one the preset of the kind decodes
+		for _, k := range tezosKinds {
+			if k.kind != kind {
+				continue
+			}
+			if data, err := AddressDecode(address, k.addresstype); err != nil || hex.EncodeToString(data) != hex.EncodeToString(hash) {
+				t.Errorf("%s: preset decoded %x, %v", address, data, err)
+			}
+		}
+	}
+	if kind, _, err := TezosDecode("1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH"); err == nil {
+		t.Error("bitcoin address decoded as", kind)
+	}
+}
@@ -0,0 +1,161 @@
+package addressEncoder
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+
+	"github.com/blocktree/go-owcdrivers/addressEncoder/bech32"
+)
+
+var (
+	ErrorInvalidUnifiedAddress = errors.New("Invalid unified address!")
+)
+
+// unifiedAddressHRPs are the human readable parts of the zcash mainnet, testnet and regtest unified addresses
+var unifiedAddressHRPs = []string{"u", "utest", "uregtest"}
+
+// f4jumble limits of the message length, ZIP-316
+const (
+	f4jumbleMinLength = 48
+	f4jumbleMaxLength = 4194368
+)
+
+func f4jumbleH(i byte, u []byte, size int) []byte {
+	return blake2bPersonal(u, size, append([]byte("UA_F4Jumble_H"), i, 0, 0))
+}
+
+func f4jumbleG(i byte, u []byte, size int) []byte {
+	out := make([]byte, 0, size+64)
+	for j := 0; len(out) < size; j++ {
+		personal := append([]byte("UA_F4Jumble_G"), i, 0, 0)
+		binary.LittleEndian.PutUint16(personal[14:], uint16(j))
+		out = append(out, blake2bPersonal(u, 64, personal)...)
+	}
+	return out[:size]
+}
+
+func xorBytes(dst, src []byte) {
+	for i := range dst {
+		dst[i] ^= src[i]
+	}
+}
+
+// f4jumbleSplit returns the lengths of the left and right parts of a message of n bytes
+func f4jumbleSplit(n int) (int, int) {
+	left := n / 2
+	if left > 64 {
+		left = 64
+	}
+	return left, n - left
+}
+
+// f4jumble is the unkeyed 4-round Feistel construction of ZIP-316 that makes every character of
+// a unified address depend on all of its receivers
+func f4jumble(m []byte) []byte {
+	left, right := f4jumbleSplit(len(m))
+	out := make([]byte, len(m))
+	copy(out, m)
+	a, b := out[:left], out[left:]
+	xorBytes(b, f4jumbleG(0, a, right))
+	xorBytes(a, f4jumbleH(0, b, left))
+	xorBytes(b, f4jumbleG(1, a, right))
+	xorBytes(a, f4jumbleH(1, b, left))
+	return out
+}
+
+// f4jumbleInv reverses f4jumble by running the rounds backwards
+func f4jumbleInv(m []byte) []byte {
+	left, right := f4jumbleSplit(len(m))
+	out := make([]byte, len(m))
+	copy(out, m)
+	c, d := out[:left], out[left:]
+	xorBytes(c, f4jumbleH(1, d, left))
+	xorBytes(d, f4jumbleG(1, c, right))
+	xorBytes(c, f4jumbleH(0, d, left))
+	xorBytes(d, f4jumbleG(0, c, right))
+	return out
+}
+
+// readCompactSize reads a bitcoin style compact size from data and returns it with the bytes it took,
+// non-minimal encodings are rejected
+func readCompactSize(data []byte) (uint64, int, bool) {
+	if len(data) < 1 {
+		return 0, 0, false
+	}
+	switch data[0] {
+	case 0xfd:
+		if len(data) < 3 {
+			return 0, 0, false
+		}
+		v := uint64(binary.LittleEndian.Uint16(data[1:]))
+		return v, 3, v >= 0xfd
+	case 0xfe:
+		if len(data) < 5 {
+			return 0, 0, false
+		}
+		v := uint64(binary.LittleEndian.Uint32(data[1:]))
+		return v, 5, v > 0xffff
+	case 0xff:
+		if len(data) < 9 {
+			return 0, 0, false
+		}
+		v := binary.LittleEndian.Uint64(data[1:])
+		return v, 9, v > 0xffffffff
+	}
+	return uint64(data[0]), 1, true
+}
+
+// validReceivers reports whether data is a sequence of typecode, length and value receiver encodings
+func validReceivers(data []byte) bool {
+	if len(data) == 0 {
+		return false
+	}
+	for len(data) > 0 {
+		_, n, ok := readCompactSize(data)
+		if !ok {
+			return false
+		}
+		data = data[n:]
+		length, n, ok := readCompactSize(data)
+		if !ok || length > uint64(len(data)-n) {
+			return false
+		}
+		data = data[n+int(length):]
+	}
+	return true
+}
+
+// UnifiedAddressDecode decodes a zcash unified address (u1..., utest1...): it checks the bech32m checksum,
+// reverses F4Jumble and the 16-byte padding of the human readable part, and returns the receiver encodings
+// as they are, each a compact size typecode, a compact size length and the receiver itself.
+// The receivers are only checked to be well formed, not what they hold.
+func UnifiedAddressDecode(address string) ([]byte, error) {
+	hrp, groups, variant, err := bech32.DecodeRawVariant(address)
+	if err != nil || variant != bech32.VariantBech32m {
+		return nil, ErrorInvalidAddress
+	}
+	known := false
+	for _, h := range unifiedAddressHRPs {
+		known = known || h == hrp
+	}
+	if !known {
+		return nil, ErrorInvalidAddress
+	}
+	jumbled, err := bech32.ConvertBits(groups, 5, 8, false)
+	if err != nil || len(jumbled) < f4jumbleMinLength || len(jumbled) > f4jumbleMaxLength {
+		return nil, ErrorInvalidUnifiedAddress
+	}
+
+	data := f4jumbleInv(jumbled)
+	padding := make([]byte, 16)
+	copy(padding, hrp)
+	if !bytes.HasSuffix(data, padding) {
+		return nil, ErrorInvalidUnifiedAddress
+	}
+	receivers := data[:len(data)-16]
+	if !validReceivers(receivers) {
+		return nil, ErrorInvalidUnifiedAddress
+	}
+	return receivers, nil
+}
@@ -0,0 +1,105 @@
+package addressEncoder
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+// the blake2b and 0..n F4Jumble values come from an independent python implementation of ZIP-316 on top of
+// hashlib.blake2b, the other F4Jumble and unified address vectors are published ones
+
+func Test_blake2bPersonal(t *testing.T) {
+	data := make([]byte, 200)
+	for i := range data {
+		data[i] = byte(i)
+	}
+	personal := []byte("ZcashTestPerson!")
+
+	if ret := hex.EncodeToString(blake2bPersonal([]byte("abc"), 64, personal)); ret != "7968eb2303bff4342fe50258a359a3e8a0a7c846789d52664b9a43e08016f04ffecad85cdf2a7414fd9275a2b2cf06ad852f626bbf4d597640d5a43868b0bd82" {
+		t.Error("abc:", ret)
+	}
+	// more than one block
+	if ret := hex.EncodeToString(blake2bPersonal(data, 32, personal)); ret != "8d54b18b8b54f33d0892df2e5a951280eef706589100541d6dda54e8ddfc65f8" {
+		t.Error("200 bytes:", ret)
+	}
+	// a zero personalization is plain blake2b
+	if ret := hex.EncodeToString(blake2bPersonal(nil, 64, make([]byte, 16))); ret != "786a02f742015903c6c6fd852552d272912f4740e15847618a86e217f71f5419d25e1031afee585313896444934eb04b903a685b1448b755d56f701afe9be2ce" {
+		t.Error("empty input:", ret)
+	}
+}
+
+func Test_f4jumble(t *testing.T) {
+	tests := map[int]string{
+		48:  "ad89bfac63c78b1cc325661c40cc56b291cf50be748dba7bc0b74851fc87ac797da311647be438dcd8df735a3361a8d1",
+		200: "b23b9554c2ac6e0222c9546061472c0d67a83a782d4cbe7c7564cd5068adf74056036dabf15136f739a0703313c9888c34b51550424912a93fac0b0c87dbba19cbe304296511b8b26e4db1033c24e207e78de0834e17f41bd303cbfe6c70a306ed5a8e5fa88450779776cd0a5c651abfffcb49cf25db47a80ac1c6b80ecf963f2a33038fd0add7240185a86c6ddb422493fe3e3d1a3f7a5e0e10886d638ff606a477aaaa01ea0ab6fa1baac3787d525a596f820c4f46b99e71ae3d7d117e5849aedd9ffcc16bbc55",
+	}
+	for n, expect := range tests {
+		m := make([]byte, n)
+		for i := range m {
+			m[i] = byte(i)
+		}
+		jumbled := f4jumble(m)
+		if hex.EncodeToString(jumbled) != expect {
+			t.Errorf("%d bytes: jumbled %x", n, jumbled)
+		}
+		if ret := f4jumbleInv(jumbled); hex.EncodeToString(ret) != hex.EncodeToString(m) {
+			t.Errorf("%d bytes: unjumbled %x", n, ret)
+		}
+	}
+
+	// the first vector of zcash-test-vectors f4jumble
+	normal, _ := hex.DecodeString("5d7a8f739a2d9e945b0ce152a8049e294c4d6e66b164939daffa2ef6ee6921481cdd86b3cc4318d9614fc820905d042b")
+	expect := "0304d029141b995da5387c125970673504d6c764d91ea6c082123770c7139ccd88ee27368cd0c0921a0444c8e5858d22"
+	jumbled := f4jumble(normal)
+	if hex.EncodeToString(jumbled) != expect {
+		t.Errorf("vector 1: jumbled %x", jumbled)
+	}
+	if ret := f4jumbleInv(jumbled); hex.EncodeToString(ret) != hex.EncodeToString(normal) {
+		t.Errorf("vector 1: unjumbled %x", ret)
+	}
+}
+
+func Test_UnifiedAddressDecode_published(t *testing.T) {
+	// mainnet unified addresses of the zcash documentation, a p2pkh and a sapling receiver, then the same
+	// with an orchard receiver
+	tests := map[string]string{
+		"u1l8xunezsvhq8fgzfl7404m450nwnd76zshscn6nfys7vyz2ywyh4cc5daaq0c7q2su5lqfh23sp7fkf3kt27ve5948mzpfdvckzaect2jtte308mkwlycj2u0eac077wu70vqcetkxf":                                                                         "00147bb83570b8fae146e03c5331a020b1e0892f631d022bd8ef8293d26de832e7193f296ba1922d90f122c6135bc231eebd91efdb03b1a8606771cd4fd6480574d43e",
+		"u1pg2aaph7jp8rpf6yhsza25722sg5fcn3vaca6ze27hqjw7jvvhhuxkpcg0ge9xh6drsgdkda8qjq5chpehkcpxf87rnjryjqwymdheptpvnljqqrjqzjwkc2ma6hcq666kgwfytxwac8eyex6ndgr6ezte66706e3vaqrd25dzvzkc69kw0jgywtd0cmq52q5lkw6uh7hyvzjse8ksx": "0014cad268758c5e71493066446b98e71df9d1d6a5ca022b9f6e0bf90a18fc0b9b83ae9f23ad4358648638482b5def8975635b66fd8a708335f9235a3186ec0f033f84032bcecbe5e689a453a3fe10ccf7617e6c1fb382819d7fc9200a1f42092ac84a30378f8c1fb90dff71a6d5042d",
+	}
+	for address, expect := range tests {
+		ret, err := UnifiedAddressDecode(address)
+		if err != nil || hex.EncodeToString(ret) != expect {
+			t.Errorf("%s: decoded %x, %v", address, ret, err)
+		}
+	}
+}
+
+func Test_UnifiedAddressDecode(t *testing.T) {
+	// a p2pkh receiver of the bytes 0..19 and a sapling receiver of the bytes 0x20..0x4a
+	receivers := "0014000102030405060708090a0b0c0d0e0f10111213022b202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f404142434445464748494a"
+	for _, address := range []string{
+		"u1geg2m5y5wsa837wggzrf6skct4des3thn2czz6758q3fcq89hl906wej322qps99jksjv5llp727gq2wwfs958nennvx4432at270tsndxf2pkzujypzhn4vpfv2yx0hx857uwsew50",
+		"utest140e4fe0l55d60hmztq47n7vtkty56p3tlvkafuqruuskcy3fcs6sgnu4gqc8enm2c6vkd8vrnckclg7yd2u4tdtm073txhcugtly4wg3yd3r389pgd3a5zx5sfnn36hcf8thuzy52yd",
+	} {
+		ret, err := UnifiedAddressDecode(address)
+		if err != nil || hex.EncodeToString(ret) != receivers {
+			t.Errorf("%s: decoded %x, %v", address, ret, err)
+		}
+	}
+
+	tests := map[string]error{
+		// bech32 checksum instead of bech32m
+		"u1geg2m5y5wsa837wggzrf6skct4des3thn2czz6758q3fcq89hl906wej322qps99jksjv5llp727gq2wwfs958nennvx4432at270tsndxf2pkzujypzhn4vpfv2yx0hx857umvfz3d": ErrorInvalidAddress,
+		"u1geg2m5y5wsa837wggzrf6skct4des3thn2czz6758q3fcq89hl906wej322qps99jksjv5llp727gq2wwfs958nennvx4432at270tsndxf2pkzujypzhn4vpfv2yx0hx857uwsew5q": ErrorInvalidAddress,
+		// the testnet padding under the mainnet human readable part
+		"u140e4fe0l55d60hmztq47n7vtkty56p3tlvkafuqruuskcy3fcs6sgnu4gqc8enm2c6vkd8vrnckclg7yd2u4tdtm073txhcugtly4wg3yd3r389pgd3a5zx5sfnn36hcf8thue99hv7": ErrorInvalidUnifiedAddress,
+		// the sapling receiver claims 44 bytes
+		"u1dzuukl9mj59ce3zddw4txkydvpceunpw5099j5y0vxn55mgujvn4gvfmxnpvalf98r3lzqgsv39mvd2md9k6vysuy5lt5yv42vwxx4qvtr63tuzjh8cx832hl6m7yc99uchq7kmdaus": ErrorInvalidUnifiedAddress,
+		"bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4": ErrorInvalidAddress,
+	}
+	for address, expect := range tests {
+		if ret, err := UnifiedAddressDecode(address); err != expect {
+			t.Errorf("%s: decoded %x, %v, want %v", address, ret, err, expect)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package addressEncoder
+
+import (
+	"encoding/hex"
+	"math/big"
+	"strings"
+
+	"github.com/blocktree/go-owcrypt"
+)
+
+// zilliqaChecksum returns the 0x prefixed, mixed case checksummed form of a 20-byte zilliqa address.
+// Letter i is upper cased when bit 255-6*i of sha256(address) is set.
+func zilliqaChecksum(addr []byte) string {
+	lower := []byte(hex.EncodeToString(addr))
+	v := new(big.Int).SetBytes(owcrypt.Hash(addr, 32, owcrypt.HASH_ALG_SHA256))
+	for i, c := range lower {
+		if c >= 'a' && v.Bit(255-6*i) == 1 {
+			lower[i] = c - 32
+		}
+	}
+	return "0x" + string(lower)
+}
+
+// ZilliqaHexToBech32 converts the hex form of a zilliqa address, with or without 0x and in any case,
+// to the zil1 bech32 form.
+func ZilliqaHexToBech32(address string) (string, error) {
+	if strings.HasPrefix(address, "0x") || strings.HasPrefix(address, "0X") {
+		address = address[2:]
+	}
+	hash, err := hex.DecodeString(address)
+	if err != nil {
+		return "", ErrorInvalidAddress
+	}
+	if len(hash) != ZIL_mainnetAddress.HashLen {
+		return "", ErrorInvalidHashLength
+	}
+	return AddressEncode(hash, ZIL_mainnetAddress), nil
+}
+
+// ZilliqaBech32ToHex converts a zil1 bech32 address to the checksummed hex form.
+func ZilliqaBech32ToHex(address string) (string, error) {
+	if !strings.HasPrefix(strings.ToLower(address), ZIL_mainnetAddress.ChecksumType+"1") {
+		return "", ErrorInvalidAddress
+	}
+	hash, err := AddressDecode(address, ZIL_mainnetAddress)
+	if err != nil {
+		return "", err
+	}
+	if len(hash) != ZIL_mainnetAddress.HashLen {
+		return "", ErrorInvalidHashLength
+	}
+	return zilliqaChecksum(hash), nil
+}
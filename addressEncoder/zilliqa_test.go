@@ -0,0 +1,56 @@
+package addressEncoder
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func Test_ZIL_address(t *testing.T) {
+	hash, _ := hex.DecodeString("9bfec715a6bd658fcb62b0f8cc9bfa2ade71434a")
+	address := "zil1n0lvw9dxh4jcljmzkruvexl69t08zs62ds9ats"
+
+	if ret := AddressEncode(hash, ZIL_mainnetAddress); ret != address {
+		t.Error("encode wrong result:", ret)
+	}
+	ret, err := AddressDecode(address, ZIL_mainnetAddress)
+	if err != nil || hex.EncodeToString(ret) != hex.EncodeToString(hash) {
+		t.Error("decode wrong result:", hex.EncodeToString(ret), err)
+	}
+
+	// the hash is the last 20 bytes of sha256 over the compressed public key
+	pubkey, _ := hex.DecodeString("0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798")
+	if ret := EncodeScriptHash(pubkey, ZIL_mainnetAddress); ret != "zil198jk9ae53ry29wuah3tspvmp649ekp250ajt0a" {
+		t.Error("encode from public key wrong result:", ret)
+	}
+
+	// a 32-byte program is not a zilliqa address
+	long := AddressEncode(make([]byte, 32), ZIL_mainnetAddress)
+	if _, err := AddressDecode(long, ZIL_mainnetAddress); err != ErrorInvalidHashLength {
+		t.Errorf("%s: got %v, want ErrorInvalidHashLength", long, err)
+	}
+}
+
+func Test_ZilliqaHexBech32(t *testing.T) {
+	checksummed := "0x9BFEC715a6bD658fCb62B0f8cc9BFa2ADE71434A"
+	bech32 := "zil1n0lvw9dxh4jcljmzkruvexl69t08zs62ds9ats"
+
+	for _, in := range []string{checksummed, "9bfec715a6bd658fcb62b0f8cc9bfa2ade71434a", "0X9BFEC715A6BD658FCB62B0F8CC9BFA2ADE71434A"} {
+		if ret, err := ZilliqaHexToBech32(in); err != nil || ret != bech32 {
+			t.Errorf("%s: got %s, %v", in, ret, err)
+		}
+	}
+	if ret, err := ZilliqaBech32ToHex(bech32); err != nil || ret != checksummed {
+		t.Errorf("got %s, %v, want %s", ret, err, checksummed)
+	}
+
+	if _, err := ZilliqaHexToBech32("0x9bfec715a6bd658fcb62b0f8cc9bfa2ade7143"); err != ErrorInvalidHashLength {
+		t.Error("short hex accepted:", err)
+	}
+	if _, err := ZilliqaHexToBech32("0x9bfec715a6bd658fcb62b0f8cc9bfa2ade71434g"); err != ErrorInvalidAddress {
+		t.Error("non hex accepted:", err)
+	}
+	// a valid bech32 string of another chain
+	if _, err := ZilliqaBech32ToHex("bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4"); err == nil {
+		t.Error("bitcoin address converted")
+	}
+}
@@ -1,6 +1,6 @@
 module github.com/blocktree/go-owcdrivers
 
-go 1.12
+go 1.13
 
 require (
 	github.com/assetsadapterstore/tivalue-adapter v1.0.3
@@ -21,6 +21,7 @@ require (
 	github.com/blocktree/ripple-adapter v1.0.13
 	github.com/blocktree/virtualeconomy-adapter v1.1.5
 	github.com/blocktree/waykichain-adapter v1.0.3
+	github.com/btcsuite/btcutil v0.0.0-20190316010144-3ac1210f4b38
 	github.com/google/gofuzz v1.0.0 // indirect
 	github.com/pkg/errors v0.8.1
 	github.com/tendermint/tendermint v0.31.2-rc0